@@ -17,6 +17,10 @@ type SampleClient struct {
 	GameID     gameID
 	Config     GameConfig
 	Stream     pb.Game_StreamClient
+	// secret from the join response, which is used to resume the game
+	ReconnectToken string
+	// sequence number of the last event received with ReceiveEvent
+	LastSeenSequence int64
 }
 
 func NewSampleClient() *SampleClient {
@@ -35,16 +39,28 @@ func (c *SampleClient) Connect(addr string) error {
 }
 
 func (c *SampleClient) ProcessJoinResponse(res *pb.JoinResponse) {
+	questionWinPercentages := make(map[string]int32)
+	for _, winPercentage := range res.QuestionWinPercentages {
+		difficulty := questionDifficultiesFromPB[winPercentage.Difficulty]
+		questionWinPercentages[difficulty] = winPercentage.WinPercentage
+	}
+
 	c.UserID = userID(res.UserId)
 	c.GameID = gameID(res.GameId)
+	c.ReconnectToken = res.ReconnectToken
+	// events of the new game are numbered from 1
+	c.LastSeenSequence = 0
 	c.Config = NewGameConfig(
 		res.Duration, res.PlayerPoints, res.BankPointsPerPlayer,
 		res.CreditInterest, res.DepositInterest,
 		res.CreditTime, res.DepositTime,
 		res.TheftTime, res.TheftPercentage,
 		res.LotteryTime, res.LotteryMaxWin,
-		res.QuestionWinPercentage,
+		questionWinPercentages,
 	)
+	c.Config.SetQuestionAnswerTime(res.QuestionAnswerTime)
+	c.Config.SetLotteryPayouts(res.LotteryCellCount, res.LotteryCellValues, false)
+	c.Config.SetLotteryTicket(res.LotteryTicketPrice, res.LotteryJackpotShare)
 }
 
 func (c *SampleClient) JoinGame() (*pb.JoinResponse, error) {
@@ -62,6 +78,78 @@ func (c *SampleClient) JoinGame() (*pb.JoinResponse, error) {
 	return res, nil
 }
 
+// JoinLobby joins the lobby with the join code.
+func (c *SampleClient) JoinLobby(joinCode string) (*pb.JoinResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetJoinLobbyRequest(joinCode)
+	res, err := c.GameClient.Join(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join lobby: %v", err)
+	}
+	c.ProcessJoinResponse(res)
+	return res, nil
+}
+
+// CreateLobby creates a new lobby and joins it.
+func (c *SampleClient) CreateLobby(name string, public bool) (*pb.JoinResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetCreateLobbyRequest(name, public)
+	res, err := c.GameClient.CreateLobby(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lobby: %v", err)
+	}
+	c.ProcessJoinResponse(res)
+	return res, nil
+}
+
+// Rematch joins the rematch lobby of the finished game of the client.
+func (c *SampleClient) Rematch() (*pb.JoinResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetRematchRequest()
+	res, err := c.GameClient.Rematch(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join rematch: %v", err)
+	}
+	c.ProcessJoinResponse(res)
+	return res, nil
+}
+
+// ConfigureLobby replaces the config of the lobby created by the client.
+func (c *SampleClient) ConfigureLobby(config GameConfig) error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetConfigureLobbyRequest(config)
+	_, err := c.GameClient.ConfigureLobby(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to configure lobby: %v", err)
+	}
+	c.Config = config
+	return nil
+}
+
+func (c *SampleClient) ListLobbies() (*pb.ListLobbiesResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	res, err := c.GameClient.ListLobbies(context.Background(), &pb.ListLobbiesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lobbies: %v", err)
+	}
+	return res, nil
+}
+
 func (c *SampleClient) LeaveGame() error {
 	if c.GameClient == nil {
 		return fmt.Errorf("Client is not connected to server")
@@ -76,6 +164,34 @@ func (c *SampleClient) LeaveGame() error {
 	return nil
 }
 
+// Kick deletes the player from the game, if the client is the host.
+func (c *SampleClient) Kick(kickedUserID string) error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetKickRequest(kickedUserID)
+	_, err := c.GameClient.Kick(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to kick player: %v", err)
+	}
+	return nil
+}
+
+// LockLobby locks or unlocks the lobby, if the client is the host.
+func (c *SampleClient) LockLobby(locked bool) error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetLockLobbyRequest(locked)
+	_, err := c.GameClient.LockLobby(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to lock lobby: %v", err)
+	}
+	return nil
+}
+
 func (c *SampleClient) OpenStream() error {
 	if c.GameClient == nil {
 		return fmt.Errorf("Client is not connected to server")
@@ -91,6 +207,65 @@ func (c *SampleClient) OpenStream() error {
 	return nil
 }
 
+// Resume opens the stream with the reconnect token
+// and returns the snapshot of the game. Next events are
+// received from the stream in the same way as after OpenStream.
+func (c *SampleClient) Resume() (*pb.StreamResponse_Snapshot, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetResumeRequest()
+	stream, err := c.GameClient.Resume(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume game: %v", err)
+	}
+	c.Stream = stream
+	res, err := c.ReceiveEvent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive snapshot: %v", err)
+	}
+	snapshot := res.GetSnapshot()
+	if snapshot == nil {
+		return nil, fmt.Errorf("expected snapshot, received: %v", res)
+	}
+	c.UserID = userID(snapshot.UserId)
+	log.Printf("Player %v resumed game successfully.\n", c.UserID)
+	return snapshot, nil
+}
+
+// Spectate opens the stream of the game without joining it.
+func (c *SampleClient) Spectate(id string) error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	c.GameID = gameID(id)
+	req := c.GetSpectateRequest()
+	stream, err := c.GameClient.Spectate(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to spectate game: %v", err)
+	}
+	c.Stream = stream
+	log.Printf("Spectator opened stream of game %v successfully.\n", c.GameID)
+	return nil
+}
+
+// ReceiveEvent receives the next event from the stream
+// and remembers its sequence number, so that the stream
+// can be reopened without missing events.
+func (c *SampleClient) ReceiveEvent() (*pb.StreamResponse, error) {
+	if c.Stream == nil {
+		return nil, fmt.Errorf("stream is not opened")
+	}
+	res, err := c.Stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	c.LastSeenSequence = res.Sequence
+	return res, nil
+}
+
 func (c *SampleClient) StartGame() error {
 	if c.GameClient == nil {
 		return fmt.Errorf("client is not connected to server")
@@ -105,6 +280,60 @@ func (c *SampleClient) StartGame() error {
 	return nil
 }
 
+// PauseGame stops the timers of the game, if the client is the host.
+func (c *SampleClient) PauseGame() error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetPauseGameRequest()
+	_, err := c.GameClient.PauseGame(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to pause the game: %v", err)
+	}
+	return nil
+}
+
+// ResumeGame starts the timers of the paused game, if the client is the host.
+func (c *SampleClient) ResumeGame() error {
+	if c.GameClient == nil {
+		return fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetResumeGameRequest()
+	_, err := c.GameClient.ResumeGame(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to resume the game: %v", err)
+	}
+	return nil
+}
+
+func (c *SampleClient) GetGameState() (*pb.GetGameStateResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetGameStateRequest()
+	res, err := c.GameClient.GetGameState(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game state: %v", err)
+	}
+	return res, nil
+}
+
+func (c *SampleClient) GetGameSummary() (*pb.GameSummary, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	req := c.GetGameSummaryRequest()
+	res, err := c.GameClient.GetGameSummary(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game summary: %v", err)
+	}
+	return res.Summary, nil
+}
+
 func (c *SampleClient) TakeCredit(val int32) (*pb.CreditResponse, error) {
 	if c.GameClient == nil {
 		return nil, fmt.Errorf("client is not connected to server")
@@ -156,19 +385,52 @@ func (c *SampleClient) PlayLottery(cellIndex int32) (*pb.LotteryResponse, error)
 	return res, nil
 }
 
+// PlayCommittedLottery requests the commitment of the lottery board,
+// plays the lottery and verifies the revealed board.
+func (c *SampleClient) PlayCommittedLottery(cellIndex int32) (*pb.LotteryResponse, error) {
+	if c.GameClient == nil {
+		return nil, fmt.Errorf("client is not connected to server")
+	}
+
+	commitmentRes, err := c.GameClient.LotteryCommitment(context.Background(), c.GetLotteryCommitmentRequest())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lottery commitment: %v", err)
+	}
+
+	res, err := c.PlayLottery(cellIndex)
+	if err != nil || !res.Success {
+		return res, err
+	}
+	if res.Commitment != commitmentRes.Commitment {
+		return nil, fmt.Errorf(
+			"lottery commitment %s differs from the requested %s", res.Commitment, commitmentRes.Commitment,
+		)
+	}
+	if err := VerifyLottery(res.Commitment, res.Salt, res.CellValues, cellIndex, res.WinPoints); err != nil {
+		return nil, fmt.Errorf("lottery verification failed: %v", err)
+	}
+	return res, nil
+}
+
 func (c *SampleClient) DoGenerateQuestion(bidPoints int32) (*pb.GenerateQuestionResponse, error) {
+	return c.DoGenerateFilteredQuestion(bidPoints, pb.QuestionType_MULTIPLE, pb.QuestionDifficulty_EASY, "")
+}
+
+func (c *SampleClient) DoGenerateFilteredQuestion(
+	bidPoints int32, questionType pb.QuestionType, difficulty pb.QuestionDifficulty, category string,
+) (*pb.GenerateQuestionResponse, error) {
 	if c.GameClient == nil {
 		return nil, fmt.Errorf("client is not connected to server")
 	}
 
-	req := c.GetGenerateQuestionRequest(bidPoints)
+	req := c.GetGenerateQuestionRequest(bidPoints, questionType, difficulty, category)
 	res, err := c.GameClient.GenerateQuestion(context.Background(), req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate question: %v", err)
 	}
 	log.Printf(
-		"user %v, bid points %v, question id: %v, question: %v, answers: %v\n",
-		c.UserID, bidPoints, res.QuestionId, res.Question, res.Answers,
+		"user %v, bid points %v, question id: %v, question: %v, answers: %v, answer range: [%v, %v]\n",
+		c.UserID, bidPoints, res.QuestionId, res.Question, res.Answers, res.AnswerMin, res.AnswerMax,
 	)
 	return res, nil
 }
@@ -184,8 +446,8 @@ func (c *SampleClient) DoAnswerQuestion(qID string, userAnswer int32) (*pb.Answe
 		return nil, fmt.Errorf("failed to answer question: %v", err)
 	}
 	log.Printf(
-		"user %v, question id: %v, user answer: %v, answer_is_correct: %v, correct answer: %v, win points: %v",
-		c.UserID, qID, userAnswer, res.AnswerIsCorrect, res.CorrectAnswer, res.WinPoints,
+		"user %v, question id: %v, user answer: %v, answer_is_correct: %v, correct answer: %v, win points: %v, expired: %v",
+		c.UserID, qID, userAnswer, res.AnswerIsCorrect, res.CorrectAnswer, res.WinPoints, res.Expired,
 	)
 	return res, nil
 }
@@ -196,6 +458,36 @@ func (c *SampleClient) GetJoinRequest() *pb.JoinRequest {
 	}
 }
 
+func (c *SampleClient) GetJoinLobbyRequest(joinCode string) *pb.JoinRequest {
+	return &pb.JoinRequest{
+		Username: string(c.Username),
+		JoinCode: joinCode,
+	}
+}
+
+func (c *SampleClient) GetCreateLobbyRequest(name string, public bool) *pb.CreateLobbyRequest {
+	return &pb.CreateLobbyRequest{
+		Username: string(c.Username),
+		Name:     name,
+		Public:   public,
+	}
+}
+
+func (c *SampleClient) GetRematchRequest() *pb.RematchRequest {
+	return &pb.RematchRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetConfigureLobbyRequest(config GameConfig) *pb.ConfigureLobbyRequest {
+	return &pb.ConfigureLobbyRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+		Config: config.toPB(),
+	}
+}
+
 func (c *SampleClient) GetLeaveRequest() *pb.LeaveRequest {
 	return &pb.LeaveRequest{
 		UserId: string(c.UserID),
@@ -203,16 +495,75 @@ func (c *SampleClient) GetLeaveRequest() *pb.LeaveRequest {
 	}
 }
 
-func (c *SampleClient) GetStreamRequest() *pb.StreamRequest {
-	return &pb.StreamRequest{
+func (c *SampleClient) GetKickRequest(kickedUserID string) *pb.KickRequest {
+	return &pb.KickRequest{
+		UserId:       string(c.UserID),
+		GameId:       string(c.GameID),
+		KickedUserId: kickedUserID,
+	}
+}
+
+func (c *SampleClient) GetLockLobbyRequest(locked bool) *pb.LockLobbyRequest {
+	return &pb.LockLobbyRequest{
 		UserId: string(c.UserID),
 		GameId: string(c.GameID),
+		Locked: locked,
+	}
+}
+
+func (c *SampleClient) GetStreamRequest() *pb.StreamRequest {
+	return &pb.StreamRequest{
+		UserId:           string(c.UserID),
+		GameId:           string(c.GameID),
+		LastSeenSequence: c.LastSeenSequence,
+	}
+}
+
+func (c *SampleClient) GetResumeRequest() *pb.ResumeRequest {
+	return &pb.ResumeRequest{
+		GameId:         string(c.GameID),
+		ReconnectToken: c.ReconnectToken,
+	}
+}
+
+func (c *SampleClient) GetSpectateRequest() *pb.SpectateRequest {
+	return &pb.SpectateRequest{
+		GameId:           string(c.GameID),
+		LastSeenSequence: c.LastSeenSequence,
 	}
 }
 
 func (c *SampleClient) GetStartRequest() *pb.StartRequest {
 	return &pb.StartRequest{
 		GameId: string(c.GameID),
+		UserId: string(c.UserID),
+	}
+}
+
+func (c *SampleClient) GetPauseGameRequest() *pb.PauseGameRequest {
+	return &pb.PauseGameRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetResumeGameRequest() *pb.ResumeGameRequest {
+	return &pb.ResumeGameRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetGameStateRequest() *pb.GetGameStateRequest {
+	return &pb.GetGameStateRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetGameSummaryRequest() *pb.GetGameSummaryRequest {
+	return &pb.GetGameSummaryRequest{
+		GameId: string(c.GameID),
 	}
 }
 
@@ -240,11 +591,23 @@ func (c *SampleClient) GetLotteryRequest(cellIndex int32) *pb.LotteryRequest {
 	}
 }
 
-func (c *SampleClient) GetGenerateQuestionRequest(bidPoints int32) *pb.GenerateQuestionRequest {
+func (c *SampleClient) GetLotteryCommitmentRequest() *pb.LotteryCommitmentRequest {
+	return &pb.LotteryCommitmentRequest{
+		UserId: string(c.UserID),
+		GameId: string(c.GameID),
+	}
+}
+
+func (c *SampleClient) GetGenerateQuestionRequest(
+	bidPoints int32, questionType pb.QuestionType, difficulty pb.QuestionDifficulty, category string,
+) *pb.GenerateQuestionRequest {
 	return &pb.GenerateQuestionRequest{
-		UserId:    string(c.UserID),
-		GameId:    string(c.GameID),
-		BidPoints: bidPoints,
+		UserId:     string(c.UserID),
+		GameId:     string(c.GameID),
+		BidPoints:  bidPoints,
+		Type:       questionType,
+		Difficulty: difficulty,
+		Category:   category,
 	}
 }
 
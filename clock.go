@@ -0,0 +1,110 @@
+package server
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for the server and its games.
+// All game timers have to be scheduled through it, so that
+// tests can control the time with ManualClock.
+type Clock interface {
+	Now() time.Time
+	// AfterFunc calls f after duration d.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a function call scheduled by Clock.
+type Timer interface {
+	// Stop prevents the call and returns false,
+	// if the call has already happened or has been stopped.
+	Stop() bool
+}
+
+type realClock struct{}
+
+// NewRealClock returns Clock, which uses the system time.
+func NewRealClock() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// ManualClock is Clock, whose time moves only when Advance is called.
+// Scheduled calls are made by Advance in the order of their deadlines.
+type ManualClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []*manualTimer
+}
+
+type manualTimer struct {
+	clock    *ManualClock
+	deadline time.Time
+	f        func()
+}
+
+// NewManualClock returns ManualClock, which starts at time now.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+func (c *ManualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *ManualClock) AfterFunc(d time.Duration, f func()) Timer {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	timer := &manualTimer{clock: c, deadline: c.now.Add(d), f: f}
+	c.timers = append(c.timers, timer)
+	return timer
+}
+
+// Advance moves the time forward by d and makes all calls, which
+// are due by then. The calls are made synchronously, so they are
+// finished when Advance returns. Calls scheduled by other calls are
+// also made, if they are due.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	end := c.now.Add(d)
+	for {
+		sort.SliceStable(c.timers, func(i, j int) bool {
+			return c.timers[i].deadline.Before(c.timers[j].deadline)
+		})
+		if len(c.timers) == 0 || c.timers[0].deadline.After(end) {
+			break
+		}
+		timer := c.timers[0]
+		c.timers = c.timers[1:]
+		c.now = timer.deadline
+
+		// the call may use the clock
+		c.mutex.Unlock()
+		timer.f()
+		c.mutex.Lock()
+	}
+	c.now = end
+	c.mutex.Unlock()
+}
+
+func (t *manualTimer) Stop() bool {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+	for i, timer := range t.clock.timers {
+		if timer == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
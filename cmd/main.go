@@ -6,10 +6,82 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/cs489-team11/server"
 )
 
+var questionProviderName = flag.String(
+	"question-provider", "opentdb", "source of questions: \"opentdb\" or \"local\"",
+)
+var questionBankPath = flag.String(
+	"question-bank",
+	"questions/sample.json",
+	"comma-separated paths to question bank files (.json, .csv, .yaml) for \"local\" question provider",
+)
+var questionAnswerTime = flag.Int(
+	"question-answer-time", 30, "time in seconds given to answer a question before the bid is forfeited",
+)
+
+var lotteryCellCount = flag.Int(
+	"lottery-cells", 9, "number of cells, which can be chosen in the lottery",
+)
+var lotteryPayouts = flag.String(
+	"lottery-payouts",
+	"0,0,20,20,30,30,60,60,100",
+	"comma-separated wins for each lottery cell in percents of lottery max win, -1 for the jackpot cell",
+)
+var lotteryAbsolutePayouts = flag.Bool(
+	"lottery-absolute-payouts", false, "lottery payouts are given in points instead of percents of lottery max win",
+)
+var lotteryTicketPrice = flag.Int(
+	"lottery-ticket-price", 0, "price of a lottery ticket in points, the lottery is free if 0",
+)
+var lotteryJackpotShare = flag.Int(
+	"lottery-jackpot-share", 50, "percentage of each lottery ticket price, which goes to the jackpot",
+)
+var randomSeed = flag.Int64(
+	"random-seed", 0, "seed of the random source of every game, a new seed is generated for each game if 0",
+)
+var streamQueueSize = flag.Int(
+	"stream-queue-size", 256, "number of events, which can wait to be sent to a single stream",
+)
+var slowStreamPolicy = flag.String(
+	"slow-stream-policy",
+	"disconnect",
+	"what to do when the stream queue is full: \"drop\" the event or \"disconnect\" the stream",
+)
+var minPlayers = flag.Int(
+	"min-players", 0, "number of players, at which the start countdown begins, 0 to start only by the host",
+)
+var maxPlayers = flag.Int(
+	"max-players", 0, "number of players, at which the game starts right away, 0 for no limit",
+)
+var startCountdown = flag.Int(
+	"start-countdown", 0, "seconds from reaching min players until the start of the game",
+)
+var rematchTime = flag.Int(
+	"rematch-time", 60, "seconds after the finish, during which players can join the rematch, 0 to disable rematches",
+)
+
+func newQuestionProvider() server.QuestionProvider {
+	switch *questionProviderName {
+	case "opentdb":
+		return server.NewOpenTDBProvider()
+	case "local":
+		provider, err := server.NewLocalQuestionProvider(strings.Split(*questionBankPath, ",")...)
+		if err != nil {
+			fmt.Printf("Failed to load question bank: %v\n", err)
+			os.Exit(1)
+		}
+		return provider
+	default:
+		fmt.Printf("Unknown question provider %q.\n", *questionProviderName)
+		os.Exit(1)
+	}
+	return nil
+}
+
 func parseArgs(
 	servAddr *string,
 	duration *int32,
@@ -23,7 +95,7 @@ func parseArgs(
 	theftPercentage *int32,
 	lotteryTime *int32,
 	lotteryMaxWin *int32,
-	questionWinPercentage *int32,
+	questionWinPercentages *map[string]int32,
 ) {
 	flag.Parse()
 	receivedArgs := flag.NArg()
@@ -116,12 +188,60 @@ func parseArgs(
 	}
 	*lotteryMaxWin = int32(arg11)
 
-	arg12, err := strconv.Atoi(flag.Arg(12))
+	arg12, err := parseQuestionWinPercentages(flag.Arg(12))
 	if err != nil {
-		fmt.Printf("%s is not an integer\n", flag.Arg(12))
+		fmt.Printf("%s is not a valid question win percentage: %v\n", flag.Arg(12), err)
 		os.Exit(2)
 	}
-	*questionWinPercentage = int32(arg12)
+	*questionWinPercentages = arg12
+}
+
+// parseQuestionWinPercentages parses either a single integer, which is
+// used for all question difficulties, or a comma-separated list of
+// percentages for each difficulty, e.g. "easy:150,medium:200,hard:300".
+func parseQuestionWinPercentages(arg string) (map[string]int32, error) {
+	res := make(map[string]int32)
+	if percentage, err := strconv.Atoi(arg); err == nil {
+		for _, difficulty := range server.QuestionDifficulties {
+			res[difficulty] = int32(percentage)
+		}
+		return res, nil
+	}
+
+	for _, item := range strings.Split(arg, ",") {
+		parts := strings.Split(item, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("%q has to be in \"difficulty:percentage\" format", item)
+		}
+		percentage, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s is not an integer", parts[1])
+		}
+		res[parts[0]] = int32(percentage)
+	}
+
+	if len(res) != len(server.QuestionDifficulties) {
+		return nil, fmt.Errorf("percentages have to be given for each of %v", server.QuestionDifficulties)
+	}
+	for _, difficulty := range server.QuestionDifficulties {
+		if _, ok := res[difficulty]; !ok {
+			return nil, fmt.Errorf("percentage for %s difficulty is missing", difficulty)
+		}
+	}
+	return res, nil
+}
+
+// parseLotteryPayouts parses a comma-separated list of integers.
+func parseLotteryPayouts(arg string) ([]int32, error) {
+	var res []int32
+	for _, item := range strings.Split(arg, ",") {
+		payout, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil {
+			return nil, fmt.Errorf("%s is not an integer", item)
+		}
+		res = append(res, int32(payout))
+	}
+	return res, nil
 }
 
 func main() {
@@ -137,7 +257,7 @@ func main() {
 	var theftPercentage int32
 	var lotteryTime int32
 	var lotteryMaxWin int32
-	var questionWinPercentage int32
+	var questionWinPercentages map[string]int32
 	parseArgs(
 		&servAddr,
 		&duration,
@@ -151,40 +271,9 @@ func main() {
 		&theftPercentage,
 		&lotteryTime,
 		&lotteryMaxWin,
-		&questionWinPercentage,
+		&questionWinPercentages,
 	)
 
-	if creditInterest <= depositInterest {
-		fmt.Printf(
-			"Credit interest (%d) has to be larger than deposit interest (%d).\n",
-			creditInterest,
-			depositInterest,
-		)
-		os.Exit(1)
-	}
-
-	if creditInterest >= 100 || depositInterest >= 100 || theftPercentage >= 100 {
-		fmt.Printf(
-			"Credit (%d), deposit (%d), theft (%d) percentages have to be less than 100 percent.\n",
-			creditInterest,
-			depositInterest,
-			theftPercentage,
-		)
-		os.Exit(1)
-	}
-
-	if creditTime >= duration || depositTime >= duration || theftTime >= duration || lotteryTime >= duration {
-		fmt.Printf(
-			"Credit (%d)sec, deposit (%d)sec, theft (%d)sec, lottery (%d)sec times have to be less than duration of a game (%d).\n",
-			creditTime,
-			depositTime,
-			theftTime,
-			lotteryTime,
-			duration,
-		)
-		os.Exit(1)
-	}
-
 	gameConfig := server.NewGameConfig(
 		duration,
 		playerPoints,
@@ -197,10 +286,30 @@ func main() {
 		theftPercentage,
 		lotteryTime,
 		lotteryMaxWin,
-		questionWinPercentage,
+		questionWinPercentages,
 	)
+	gameConfig.SetQuestionAnswerTime(int32(*questionAnswerTime))
+
+	payouts, err := parseLotteryPayouts(*lotteryPayouts)
+	if err != nil {
+		fmt.Printf("%s is not a valid list of lottery payouts: %v\n", *lotteryPayouts, err)
+		os.Exit(2)
+	}
+	gameConfig.SetLotteryPayouts(int32(*lotteryCellCount), payouts, !*lotteryAbsolutePayouts)
+	gameConfig.SetLotteryTicket(int32(*lotteryTicketPrice), int32(*lotteryJackpotShare))
+	if *randomSeed != 0 {
+		gameConfig.SetRandomSeed(*randomSeed)
+	}
+	gameConfig.SetStreamQueue(int32(*streamQueueSize), *slowStreamPolicy)
+	gameConfig.SetAutoStart(int32(*minPlayers), int32(*maxPlayers), int32(*startCountdown))
+	gameConfig.SetRematchTime(int32(*rematchTime))
+
+	if err := gameConfig.Validate(); err != nil {
+		fmt.Printf("Invalid game config: %v.\n", err)
+		os.Exit(1)
+	}
 
-	s := server.NewServer(gameConfig)
+	s := server.NewServer(gameConfig, newQuestionProvider())
 	if _, err := s.Listen(servAddr); err != nil {
 		log.Fatalf("Server failed to listen: %v", err)
 	}
@@ -4,8 +4,7 @@ import (
 	"fmt"
 	"log"
 	"math"
-	"reflect"
-	"sync"
+	"sort"
 	"time"
 
 	"github.com/cs489-team11/server/pb"
@@ -24,20 +23,66 @@ const (
 // GameConfig contains game configuration variables, which
 // can be subject to change.
 type GameConfig struct {
-	duration              int32 // total game time in seconds
-	playerPoints          int32
-	bankPointsPerPlayer   int32
-	creditInterest        int32
-	depositInterest       int32
-	creditTime            int32
-	depositTime           int32
-	theftTime             int32
-	theftPercentage       int32
-	lotteryTime           int32
-	lotteryMaxWin         int32
-	questionWinPercentage int32
+	duration            int32 // total game time in seconds
+	playerPoints        int32
+	bankPointsPerPlayer int32
+	creditInterest      int32
+	depositInterest     int32
+	creditTime          int32
+	depositTime         int32
+	theftTime           int32
+	theftPercentage     int32
+	lotteryTime         int32
+	lotteryMaxWin       int32
+	lotteryCellCount    int32
+	// win for each lottery cell, either in points or
+	// in percents of lotteryMaxWin
+	lotteryPayouts             []int32
+	lotteryPayoutsInPercentage bool
+	lotteryTicketPrice         int32 // 0 if lottery is free
+	lotteryJackpotShare        int32 // percentage of ticket price, which goes to jackpot
+	// seed of the random source of each game, which is
+	// generated for every game, unless randomSeedIsSet
+	randomSeed      int64
+	randomSeedIsSet bool
+	// percentage of bid points won for correct answer for each question difficulty
+	questionWinPercentages map[string]int32
+	questionAnswerTime     int32 // time in seconds given to answer a question
+	// number of events, which can wait to be sent to a stream
+	streamQueueSize int32
+	// what to do with the stream, whose queue is full
+	slowStreamPolicy string
+	// auto-start rules, 0 disables the rule
+	minPlayers     int32 // countdown begins, when the game has this many players
+	maxPlayers     int32 // game starts right away, when it is full
+	startCountdown int32 // seconds from reaching min players until the start
+	// seconds after the finish, during which players can join the rematch, 0 disables it
+	rematchTime int32
 }
 
+// defaultQuestionAnswerTime is used, unless another
+// question answer time is set for the config.
+const defaultQuestionAnswerTime = 30
+
+// defaultStreamQueueSize and defaultSlowStreamPolicy are used,
+// unless other stream settings are set for the config.
+const (
+	defaultStreamQueueSize  = 256
+	defaultSlowStreamPolicy = disconnectSlowStreamPolicy
+)
+
+// defaultRematchTime is used, unless another
+// rematch time is set for the config.
+const defaultRematchTime = 60
+
+// defaultLotteryPayouts are percentages of lottery max win
+// for each of 9 cells, unless other payouts are set for the config.
+var defaultLotteryPayouts = []int32{0, 0, 20, 20, 30, 30, 60, 60, 100}
+
+// LotteryJackpotPayout is the payout of the lottery cell, which
+// pays the whole jackpot. It can be used only with paid tickets.
+const LotteryJackpotPayout = -1
+
 // NewGameConfig returns pointer to a newly created
 // instance of a GameConfig type.
 func NewGameConfig(
@@ -52,133 +97,728 @@ func NewGameConfig(
 	theftPercentage int32,
 	lotteryTime int32,
 	lotteryMaxWin int32,
-	questionWinPercentage int32,
+	questionWinPercentages map[string]int32,
 ) GameConfig {
 	return GameConfig{
-		duration:              duration,
-		playerPoints:          playerPoints,
-		bankPointsPerPlayer:   bankPointsPerPlayer,
-		creditInterest:        creditInterest,
-		depositInterest:       depositInterest,
-		creditTime:            creditTime,
-		depositTime:           depositTime,
-		theftTime:             theftTime,
-		theftPercentage:       theftPercentage,
-		lotteryTime:           lotteryTime,
-		lotteryMaxWin:         lotteryMaxWin,
-		questionWinPercentage: questionWinPercentage,
+		duration:                   duration,
+		playerPoints:               playerPoints,
+		bankPointsPerPlayer:        bankPointsPerPlayer,
+		creditInterest:             creditInterest,
+		depositInterest:            depositInterest,
+		creditTime:                 creditTime,
+		depositTime:                depositTime,
+		theftTime:                  theftTime,
+		theftPercentage:            theftPercentage,
+		lotteryTime:                lotteryTime,
+		lotteryMaxWin:              lotteryMaxWin,
+		lotteryCellCount:           int32(len(defaultLotteryPayouts)),
+		lotteryPayouts:             defaultLotteryPayouts,
+		lotteryPayoutsInPercentage: true,
+		questionWinPercentages:     questionWinPercentages,
+		questionAnswerTime:         defaultQuestionAnswerTime,
+		streamQueueSize:            defaultStreamQueueSize,
+		slowStreamPolicy:           defaultSlowStreamPolicy,
+		rematchTime:                defaultRematchTime,
+	}
+}
+
+// SetLotteryPayouts sets the number of lottery cells and the win for
+// each cell. Payouts are either percentages of lottery max win or
+// absolute number of points. There has to be a payout for each cell.
+func (c *GameConfig) SetLotteryPayouts(cellCount int32, payouts []int32, inPercentage bool) {
+	c.lotteryCellCount = cellCount
+	c.lotteryPayouts = payouts
+	c.lotteryPayoutsInPercentage = inPercentage
+}
+
+// SetLotteryTicket makes the lottery paid. Each lottery costs ticketPrice
+// points and jackpotShare percent of it goes to the jackpot, which is won
+// in the cell with LotteryJackpotPayout. The rest goes to the bank.
+func (c *GameConfig) SetLotteryTicket(ticketPrice int32, jackpotShare int32) {
+	c.lotteryTicketPrice = ticketPrice
+	c.lotteryJackpotShare = jackpotShare
+}
+
+// SetRandomSeed makes all games created with the config use the same
+// random seed, so that the games can be reproduced. By default, the
+// seed is generated for each game and sent to players at the finish.
+func (c *GameConfig) SetRandomSeed(seed int64) {
+	c.randomSeed = seed
+	c.randomSeedIsSet = true
+}
+
+// SetStreamQueue sets the number of events, which can wait to be sent
+// to a single stream, and the policy for streams, whose queue is full
+// (one of SlowStreamPolicies).
+func (c *GameConfig) SetStreamQueue(queueSize int32, slowStreamPolicy string) {
+	c.streamQueueSize = queueSize
+	c.slowStreamPolicy = slowStreamPolicy
+}
+
+// SetAutoStart makes the game start without the host. The countdown of
+// startCountdown seconds begins, when minPlayers have joined, and is
+// cancelled, if the number of players drops below it. The game starts
+// right away, when maxPlayers have joined. 0 disables the rule.
+func (c *GameConfig) SetAutoStart(minPlayers int32, maxPlayers int32, startCountdown int32) {
+	c.minPlayers = minPlayers
+	c.maxPlayers = maxPlayers
+	c.startCountdown = startCountdown
+}
+
+// SetRematchTime sets the number of seconds after the finish, during
+// which players of the game can join the rematch. 0 disables rematches.
+func (c *GameConfig) SetRematchTime(rematchTime int32) {
+	c.rematchTime = rematchTime
+}
+
+// Validate checks that the config values are consistent
+// and can be used to create a game.
+func (c *GameConfig) Validate() error {
+	if c.duration <= 0 || c.playerPoints <= 0 {
+		return fmt.Errorf(
+			"duration (%d) and player points (%d) have to be positive", c.duration, c.playerPoints,
+		)
+	}
+
+	if c.bankPointsPerPlayer < 0 || c.depositInterest < 0 || c.theftPercentage < 0 || c.lotteryMaxWin < 0 {
+		return fmt.Errorf(
+			"bank points (%d), deposit interest (%d), theft percentage (%d), lottery max win (%d) cannot be negative",
+			c.bankPointsPerPlayer,
+			c.depositInterest,
+			c.theftPercentage,
+			c.lotteryMaxWin,
+		)
+	}
+
+	if c.creditInterest <= c.depositInterest {
+		return fmt.Errorf(
+			"credit interest (%d) has to be larger than deposit interest (%d)",
+			c.creditInterest,
+			c.depositInterest,
+		)
+	}
+
+	if c.creditInterest >= 100 || c.depositInterest >= 100 || c.theftPercentage >= 100 {
+		return fmt.Errorf(
+			"credit (%d), deposit (%d), theft (%d) percentages have to be less than 100 percent",
+			c.creditInterest,
+			c.depositInterest,
+			c.theftPercentage,
+		)
+	}
+
+	if c.creditTime >= c.duration || c.depositTime >= c.duration ||
+		c.theftTime >= c.duration || c.lotteryTime >= c.duration {
+		return fmt.Errorf(
+			"credit (%d)sec, deposit (%d)sec, theft (%d)sec, lottery (%d)sec times have to be less than duration of a game (%d)",
+			c.creditTime,
+			c.depositTime,
+			c.theftTime,
+			c.lotteryTime,
+			c.duration,
+		)
+	}
+
+	if c.creditTime <= 0 || c.depositTime <= 0 || c.theftTime <= 0 || c.lotteryTime < 0 {
+		return fmt.Errorf(
+			"credit (%d)sec, deposit (%d)sec, theft (%d)sec times have to be positive and lottery (%d)sec time cannot be negative",
+			c.creditTime,
+			c.depositTime,
+			c.theftTime,
+			c.lotteryTime,
+		)
+	}
+
+	if c.questionAnswerTime <= 0 {
+		return fmt.Errorf("question answer time (%d) has to be positive", c.questionAnswerTime)
+	}
+
+	if len(c.questionWinPercentages) != len(QuestionDifficulties) {
+		return fmt.Errorf("question win percentages have to be given for each of %v", QuestionDifficulties)
+	}
+	for _, difficulty := range QuestionDifficulties {
+		percentage, ok := c.questionWinPercentages[difficulty]
+		if !ok {
+			return fmt.Errorf("question win percentage for %s difficulty is missing", difficulty)
+		}
+		if percentage < 0 {
+			return fmt.Errorf("question win percentage for %s difficulty cannot be negative", difficulty)
+		}
+	}
+
+	if c.lotteryCellCount < 1 {
+		return fmt.Errorf("lottery has to have at least one cell, got %d", c.lotteryCellCount)
+	}
+	if int32(len(c.lotteryPayouts)) != c.lotteryCellCount {
+		return fmt.Errorf(
+			"lottery has %d cells, but %d payouts are given", c.lotteryCellCount, len(c.lotteryPayouts),
+		)
+	}
+	maxPayout := c.lotteryMaxWin
+	if c.lotteryPayoutsInPercentage {
+		maxPayout = 100
+	}
+	jackpotCells := 0
+	for _, payout := range c.lotteryPayouts {
+		if payout == LotteryJackpotPayout {
+			jackpotCells++
+			continue
+		}
+		if payout < 0 || payout > maxPayout {
+			return fmt.Errorf("lottery payout has to be from 0 to %d, got %d", maxPayout, payout)
+		}
+	}
+
+	if c.lotteryTicketPrice < 0 {
+		return fmt.Errorf("lottery ticket price (%d) cannot be negative", c.lotteryTicketPrice)
+	}
+	if c.lotteryJackpotShare < 0 || c.lotteryJackpotShare > 100 {
+		return fmt.Errorf("lottery jackpot share has to be from 0 to 100 percent, got %d", c.lotteryJackpotShare)
+	}
+	if jackpotCells > 1 {
+		return fmt.Errorf("lottery can have only one jackpot cell, got %d", jackpotCells)
+	}
+	if jackpotCells > 0 && c.lotteryTicketPrice == 0 {
+		return fmt.Errorf("lottery jackpot cell requires a ticket price")
+	}
+
+	if c.streamQueueSize < 1 {
+		return fmt.Errorf("stream queue size (%d) has to be positive", c.streamQueueSize)
+	}
+	if !containsString(SlowStreamPolicies, c.slowStreamPolicy) {
+		return fmt.Errorf(
+			"slow stream policy has to be one of %v, got %q", SlowStreamPolicies, c.slowStreamPolicy,
+		)
+	}
+
+	if c.minPlayers < 0 || c.maxPlayers < 0 || c.startCountdown < 0 {
+		return fmt.Errorf(
+			"min players (%d), max players (%d), start countdown (%d)sec cannot be negative",
+			c.minPlayers,
+			c.maxPlayers,
+			c.startCountdown,
+		)
+	}
+	if c.maxPlayers > 0 && c.minPlayers > c.maxPlayers {
+		return fmt.Errorf("min players (%d) cannot be more than max players (%d)", c.minPlayers, c.maxPlayers)
 	}
+	if c.startCountdown > 0 && c.minPlayers == 0 {
+		return fmt.Errorf("start countdown requires min players")
+	}
+
+	if c.rematchTime < 0 {
+		return fmt.Errorf("rematch time (%d) cannot be negative", c.rematchTime)
+	}
+	return nil
+}
+
+// SetQuestionAnswerTime sets the time in seconds, which players have
+// to answer the generated question. Bid points are forfeited after it.
+func (c *GameConfig) SetQuestionAnswerTime(questionAnswerTime int32) {
+	c.questionAnswerTime = questionAnswerTime
 }
 
 // Struct representing a single game.
 // Since there is only single [secondary] bank, its info
 // is also contained in this struct.
+// The state of the game is read and modified only by commands
+// executed in its event loop (see run and do). Id and question cache
+// don't change, so they can be read anywhere. Config can be changed
+// only by the host of the waiting lobby, while the server write lock
+// is held, so it can be read anywhere under the server lock.
 type game struct {
-	mutex             sync.RWMutex
 	gameID            gameID
+	joinCode          string // empty for the quick-match game
+	lobbyName         string
+	public            bool     // lobby is listed in ListLobbies
+	locked            bool     // locked lobby can't be joined
+	hostID            userID   // empty, if there are no players
+	joinOrder         []userID // players in the order, in which they have joined
 	state             gameState
+	startTime         time.Time // zero, if the game hasn't started
 	config            GameConfig
 	players           map[userID]*player
 	bankPoints        int32
+	jackpot           int32 // points collected from lottery tickets
 	lotteryCellValues []int32
+	questionCache     *questionCache
+	servedQuestions   map[string]bool // texts of questions, which have been generated in this game
+	randomSeed        int64
+	random            RandomSource // has to be used for all random events in the game
+	clock             Clock        // has to be used for all timers in the game
+	// tasks scheduled by the game, which haven't been done yet
+	scheduledTasks []*scheduledTask
+	// timers of the game don't run, while it is paused. Times of the
+	// game are moved forward by the length of the pause, when it resumes.
+	paused   bool
+	pausedAt time.Time
+	// timer of the game duration, nil if the game hasn't started
+	durationTimer *gameTimer
+	// next theft, nil if the game hasn't started
+	theftTask *scheduledTask
+	// called outside of the event loop, when the duration timer fires,
+	// so that the server can finish the game (see durationIsOver).
+	// It is set by the server before the game is shared.
+	onDurationEnd func()
+	// countdown until the auto-start, nil if it hasn't begun
+	countdownTimer Timer
+	countdownEnd   time.Time
+	// called outside of the event loop, when the countdown ends,
+	// so that the server can start the game (see startAfterCountdown).
+	// It is set by the server before the game is shared.
+	onCountdownEnd func()
+
+	// subscribers, which watch the game without playing
+	spectators map[*subscriber]bool
+
+	commands chan func()   // commands executed by the event loop
+	stopped  chan struct{} // closed, when the event loop stops
+	// sequence number of the last broadcast event
+	sequence int64
+	// all broadcast events in order, event with sequence
+	// number i is at index i-1
+	events []*pb.StreamResponse
+}
+
+// scheduledTask is a call scheduled by the game. When the game
+// finishes, pending tasks are either done right away (settled)
+// or cancelled.
+type scheduledTask struct {
+	timer          *gameTimer
+	task           func()
+	settleAtFinish bool
+}
+
+// gameTimer is a timer of the game, which is stopped, when the game
+// is paused, and started again with the remaining time after the pause.
+type gameTimer struct {
+	timer    Timer
+	deadline time.Time
+	fire     func() // called by the clock outside of the event loop
+}
+
+// start makes the timer fire after duration d.
+func (t *gameTimer) start(clock Clock, d time.Duration) {
+	t.deadline = clock.Now().Add(d)
+	t.timer = clock.AfterFunc(d, t.fire)
+}
+
+// resume starts the timer again with the time,
+// which has remained at the moment of the pause.
+func (t *gameTimer) resume(clock Clock, pausedAt time.Time) {
+	remaining := t.deadline.Sub(pausedAt)
+	if remaining < 0 {
+		remaining = 0
+	}
+	t.start(clock, remaining)
 }
 
+// errGameStopped is returned for the commands,
+// which are sent to the finished game.
+var errGameStopped = fmt.Errorf("game is finished")
+
+// errGamePaused is returned for the commands, which
+// move points, while the game is paused.
+var errGamePaused = fmt.Errorf("game is paused")
+
 func getNumberProportion(num int32, percentage int32) int32 {
 	floatRes := float64(num) * float64(percentage) / 100.0
 	res := int32(math.Ceil(floatRes))
 	return res
 }
 
-func generateLotteryCellValues(maxWin int32) []int32 {
-	// TODO: put cellCount to game config
-	cellCount := 9
-
-	res := make([]int32, cellCount)
-
-	winPoints1 := int32(0)
-	res[0] = winPoints1
-	res[1] = winPoints1
-	winPoints2 := getNumberProportion(maxWin, 20)
-	res[2] = winPoints2
-	res[3] = winPoints2
-	winPoints3 := getNumberProportion(maxWin, 30)
-	res[4] = winPoints3
-	res[5] = winPoints3
-	winPoints4 := getNumberProportion(maxWin, 60)
-	res[6] = winPoints4
-	res[7] = winPoints4
-	winPoints5 := maxWin
-	res[8] = winPoints5
-
+// generateLotteryCellValues returns the number of points,
+// which can be won in each lottery cell.
+func generateLotteryCellValues(config GameConfig) []int32 {
+	res := make([]int32, len(config.lotteryPayouts))
+	for i, payout := range config.lotteryPayouts {
+		if config.lotteryPayoutsInPercentage && payout != LotteryJackpotPayout {
+			res[i] = getNumberProportion(config.lotteryMaxWin, payout)
+		} else {
+			res[i] = payout
+		}
+	}
 	return res
 }
 
-// Creates new game in waiting state.
-func newGame(config GameConfig) *game {
+// Creates new game in waiting state and launches its event loop.
+func newGame(config GameConfig, questionCache *questionCache, clock Clock) *game {
 	gameID := gameID(uuid.New().String())
-	lotteryCellValues := generateLotteryCellValues(config.lotteryMaxWin)
-	return &game{
+	lotteryCellValues := generateLotteryCellValues(config)
+	randomSeed := config.randomSeed
+	if !config.randomSeedIsSet {
+		randomSeed = seededRand.Int63()
+	}
+	log.Printf("Game %v uses random seed %d\n", gameID, randomSeed)
+	g := &game{
 		gameID:            gameID,
 		state:             waitingState,
 		config:            config,
 		players:           make(map[userID]*player),
 		bankPoints:        0, // to be calculated in "start" function
 		lotteryCellValues: lotteryCellValues,
+		questionCache:     questionCache,
+		servedQuestions:   make(map[string]bool),
+		randomSeed:        randomSeed,
+		random:            NewRandomSource(randomSeed),
+		clock:             clock,
+		spectators:        make(map[*subscriber]bool),
+		commands:          make(chan func()),
+		stopped:           make(chan struct{}),
+	}
+	go g.run()
+	return g
+}
+
+// run is the event loop of the game. Commands are executed one by one,
+// so events are broadcast in the same order, as they happen.
+// The loop stops after the command, which finishes the game.
+func (g *game) run() {
+	for command := range g.commands {
+		command()
+		if g.state == finishedState {
+			close(g.stopped)
+			return
+		}
+	}
+}
+
+// do executes command in the event loop and waits until it is done.
+// It returns errGameStopped, if the game is finished.
+// NOTE: it cannot be called from the commands, since it would deadlock.
+func (g *game) do(command func()) error {
+	done := make(chan struct{})
+	select {
+	case g.commands <- func() {
+		command()
+		close(done)
+	}:
+		<-done
+		return nil
+	case <-g.stopped:
+		return errGameStopped
 	}
 }
 
 // Creates a new player with a provided username
-// and adds it to the game.
+// and adds it to the game. The game is started right
+// away, if the player has filled it according to
+// the auto-start rules of the config.
 // NOTE: only should be called on game in waiting state.
-func (g *game) addPlayer(username username) userID {
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-	player := newPlayer(username, g.config.playerPoints)
-	g.players[player.userID] = player
+func (g *game) addPlayer(username username) (userID userID, started bool, err error) {
+	var player *player
+	if loopErr := g.do(func() {
+		if g.locked {
+			err = fmt.Errorf("lobby is locked by the host")
+			return
+		}
 
-	// broadcasting player joining
-	go func() {
-		msg := g.getJoinMessage(player)
-		g.broadcast(msg)
-	}()
+		player = newPlayer(username, g.config.playerPoints, g.clock)
+		g.players[player.userID] = player
+		g.joinOrder = append(g.joinOrder, player.userID)
+		// the first player becomes the host
+		if g.hostID == "" {
+			g.hostID = player.userID
+		}
+
+		// broadcasting player joining
+		g.broadcast(g.getJoinMessage(player))
 
-	return player.userID
+		if g.checkAutoStart() {
+			g.handleStart()
+			started = true
+		}
+	}); loopErr != nil {
+		return "", false, loopErr
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return player.userID, started, nil
 }
 
-// Deletes player from the game.
+// Deletes player from the game and returns the number of
+// players, who are still in the game. If the host leaves,
+// the next player in the join order becomes the host.
 // NOTE: only should be called on game in waiting state.
-func (g *game) deletePlayer(userID userID) {
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
+func (g *game) deletePlayer(userID userID) (playerCount int, err error) {
+	err = g.do(func() {
+		g.removePlayer(userID)
+		playerCount = len(g.players)
+
+		// broadcasting player leaving
+		g.broadcast(g.getLeaveMessage(userID, false))
+		g.transferHost()
+		g.cancelCountdownBelowMinPlayers()
+	})
+	return playerCount, err
+}
+
+// removePlayer deletes the player from the players and the join order.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) removePlayer(userID userID) {
 	delete(g.players, userID)
+	for i, id := range g.joinOrder {
+		if id == userID {
+			g.joinOrder = append(g.joinOrder[:i], g.joinOrder[i+1:]...)
+			break
+		}
+	}
+}
+
+// transferHost makes the next player in the join order
+// the host, if the host is not in the game anymore.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) transferHost() {
+	if _, ok := g.players[g.hostID]; ok || g.hostID == "" {
+		return
+	}
+	g.hostID = ""
+	if len(g.joinOrder) == 0 {
+		return
+	}
+	g.hostID = g.joinOrder[0]
+	log.Printf("Player %v is the new host of game %v\n", g.hostID, g.gameID)
+	g.broadcast(g.getLobbyStateMessage())
+}
+
+// kickPlayer deletes the player from the waiting game.
+// Only the host can kick other players. Kicked player
+// receives the leave event, and then its stream is closed.
+func (g *game) kickPlayer(hostID userID, kickedID userID) (err error) {
+	if loopErr := g.do(func() {
+		if g.state != waitingState {
+			err = fmt.Errorf("game has already started")
+			return
+		}
+		if hostID != g.hostID {
+			err = fmt.Errorf("only the host can kick players")
+			return
+		}
+		if kickedID == g.hostID {
+			err = fmt.Errorf("host can't kick itself, leave the game instead")
+			return
+		}
+		kicked, ok := g.players[kickedID]
+		if !ok {
+			err = fmt.Errorf("kickPlayer: invalid user id %v", kickedID)
+			return
+		}
+
+		// kicked player receives the leave event too
+		g.broadcast(g.getLeaveMessage(kickedID, true))
+		g.removePlayer(kickedID)
+		if kicked.subscriber != nil {
+			kicked.subscriber.disconnect("player has been kicked by the host")
+			kicked.subscriber = nil
+		}
+		g.cancelCountdownBelowMinPlayers()
+	}); loopErr != nil {
+		return loopErr
+	}
+	return err
+}
+
+// lock locks or unlocks the waiting lobby for new players.
+// Only the host can lock the lobby.
+func (g *game) lock(userID userID, locked bool) (err error) {
+	if loopErr := g.do(func() {
+		if g.state != waitingState {
+			err = fmt.Errorf("game has already started")
+			return
+		}
+		if g.joinCode == "" {
+			err = fmt.Errorf("quick-match game can't be locked")
+			return
+		}
+		if userID != g.hostID {
+			err = fmt.Errorf("only the host can lock the lobby")
+			return
+		}
+		if g.locked == locked {
+			return
+		}
+
+		g.locked = locked
+		g.broadcast(g.getLobbyStateMessage())
+	}); loopErr != nil {
+		return loopErr
+	}
+	return err
+}
+
+// configure replaces the config of the waiting lobby.
+// Only the host can configure the lobby. Countdown begins again
+// with the new config. The game is started right away, if it is
+// full according to the new config.
+// NOTE: config has to be validated before the call.
+func (g *game) configure(userID userID, config GameConfig) (started bool, err error) {
+	if loopErr := g.do(func() {
+		if g.state != waitingState {
+			err = fmt.Errorf("game has already started")
+			return
+		}
+		if g.joinCode == "" {
+			err = fmt.Errorf("quick-match game can't be configured")
+			return
+		}
+		if userID != g.hostID {
+			err = fmt.Errorf("only the host can configure the lobby")
+			return
+		}
+
+		g.config = config
+		g.lotteryCellValues = generateLotteryCellValues(config)
+		g.broadcast(g.getConfigMessage())
+
+		g.cancelCountdown(true)
+		if g.checkAutoStart() {
+			g.handleStart()
+			started = true
+		}
+	}); loopErr != nil {
+		return false, loopErr
+	}
+	return started, err
+}
+
+// checkAutoStart begins the countdown, when the game has min players.
+// It returns true, if the game has to start right away, i.e. it is full
+// or min players are reached and there is no countdown.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) checkAutoStart() bool {
+	playerCount := int32(len(g.players))
+	if g.config.maxPlayers > 0 && playerCount >= g.config.maxPlayers {
+		return true
+	}
+	if g.config.minPlayers == 0 || playerCount < g.config.minPlayers {
+		return false
+	}
+	if g.config.startCountdown == 0 {
+		return true
+	}
+	if g.countdownTimer != nil {
+		return false
+	}
+
+	countdown := time.Duration(g.config.startCountdown) * time.Second
+	g.countdownEnd = g.clock.Now().Add(countdown)
+	g.countdownTimer = g.clock.AfterFunc(countdown, g.onCountdownEnd)
+	g.broadcast(g.getCountdownMessage(g.config.startCountdown, false))
+	return false
+}
+
+// cancelCountdownBelowMinPlayers cancels the countdown,
+// if players have left and there are less than min players.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) cancelCountdownBelowMinPlayers() {
+	if int32(len(g.players)) < g.config.minPlayers {
+		g.cancelCountdown(true)
+	}
+}
+
+// cancelCountdown stops the countdown, if it has begun.
+// Players are notified about it, if notify is true.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) cancelCountdown(notify bool) {
+	if g.countdownTimer == nil {
+		return
+	}
+	g.countdownTimer.Stop()
+	g.countdownTimer = nil
+	g.countdownEnd = time.Time{}
+	if notify {
+		g.broadcast(g.getCountdownMessage(0, true))
+	}
+}
 
-	// broadcasting player leaving
-	go func() {
-		msg := g.getLeaveMessage(userID)
-		g.broadcast(msg)
-	}()
+// remainingCountdown returns the number of seconds
+// until the auto-start, or 0 if the countdown hasn't begun.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) remainingCountdown() int32 {
+	if g.countdownTimer == nil {
+		return 0
+	}
+	return secondsUntil(g.clock.Now(), g.countdownEnd)
 }
 
-// NOTE: This function uses readlock, so it has to be used carefully.
-func (g *game) getWinnerID() userID {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
+// startAfterCountdown starts the game, if its countdown has ended.
+// It returns false, if the countdown has been cancelled or has begun
+// again in the meantime.
+func (g *game) startAfterCountdown() (started bool, err error) {
+	err = g.do(func() {
+		if g.state != waitingState || g.countdownTimer == nil || g.clock.Now().Before(g.countdownEnd) {
+			return
+		}
+		g.handleStart()
+		started = true
+	})
+	return started, err
+}
 
-	noUserID := userID("")
-	winnerID := noUserID
+// playerCount returns the number of players in the game.
+func (g *game) playerCount() (playerCount int, err error) {
+	err = g.do(func() {
+		playerCount = len(g.players)
+	})
+	return playerCount, err
+}
+
+// getSummary returns the standings of the game. Players are ordered
+// by points, and players with the same points share the rank. All
+// players with the most points are winners.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getSummary() *pb.GameSummary {
+	players := make([]*player, 0, len(g.players))
 	for _, player := range g.players {
-		if winnerID == noUserID || player.points > g.players[winnerID].points {
-			winnerID = player.userID
+		players = append(players, player)
+	}
+	// usernames and ids only make the order stable
+	sort.Slice(players, func(i, j int) bool {
+		if players[i].points != players[j].points {
+			return players[i].points > players[j].points
+		}
+		if players[i].username != players[j].username {
+			return players[i].username < players[j].username
+		}
+		return players[i].userID < players[j].userID
+	})
+
+	summary := &pb.GameSummary{}
+	rank := int32(0)
+	for i, player := range players {
+		if i == 0 || player.points != players[i-1].points {
+			rank = int32(i + 1)
+		}
+		summary.Players = append(summary.Players, player.toPBPlayerSummary(rank))
+		if rank == 1 {
+			summary.WinnerUserIds = append(summary.WinnerUserIds, string(player.userID))
 		}
 	}
-	return winnerID
+	return summary
 }
 
-func (g *game) start() {
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
+// start changes the game to the active state.
+// Only the host can start the game.
+func (g *game) start(userID userID) (err error) {
+	if loopErr := g.do(func() {
+		if userID != g.hostID {
+			err = fmt.Errorf("only the host can start the game")
+			return
+		}
+		g.handleStart()
+	}); loopErr != nil {
+		return loopErr
+	}
+	return err
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) handleStart() {
+	g.cancelCountdown(false)
 
 	g.state = activeState
+	g.startTime = g.clock.Now()
 	// bank points are calculated
 	g.bankPoints = int32(len(g.players)) * g.config.bankPointsPerPlayer
 
@@ -189,48 +829,247 @@ func (g *game) start() {
 	}
 
 	// broadcasting game start
-	go func() {
-		msg := g.getStartMessage()
-		g.broadcast(msg)
-	}()
-
-	// launch theft timer
-	time.AfterFunc(time.Duration(g.config.theftTime)*time.Second, func() {
-		g.doTheft()
+	g.broadcast(g.getStartMessage())
+
+	// launch game timers
+	g.durationTimer = &gameTimer{fire: g.onDurationEnd}
+	g.durationTimer.start(g.clock, time.Duration(g.config.duration)*time.Second)
+	g.theftTask = g.schedule(time.Duration(g.config.theftTime)*time.Second, g.doTheft, false)
+}
+
+// durationIsOver returns true, if the duration of the game has
+// passed. The game may have been paused, after the timer has fired.
+func (g *game) durationIsOver() (over bool, err error) {
+	err = g.do(func() {
+		over = !g.paused && g.durationTimer != nil && !g.clock.Now().Before(g.durationTimer.deadline)
 	})
+	return over, err
+}
+
+// pause stops all timers of the active game.
+// Only the host can pause the game.
+func (g *game) pause(userID userID) (err error) {
+	if loopErr := g.do(func() {
+		if g.state != activeState {
+			err = fmt.Errorf("game hasn't started")
+			return
+		}
+		if userID != g.hostID {
+			err = fmt.Errorf("only the host can pause the game")
+			return
+		}
+		if g.paused {
+			err = errGamePaused
+			return
+		}
+
+		g.paused = true
+		g.pausedAt = g.clock.Now()
+		g.durationTimer.timer.Stop()
+		for _, scheduled := range g.scheduledTasks {
+			scheduled.timer.timer.Stop()
+		}
+		g.broadcast(g.getPausedMessage())
+	}); loopErr != nil {
+		return loopErr
+	}
+	return err
+}
+
+// resume starts the timers of the paused game again with their
+// remaining time. Only the host can resume the game.
+func (g *game) resume(userID userID) (err error) {
+	if loopErr := g.do(func() {
+		if userID != g.hostID {
+			err = fmt.Errorf("only the host can resume the game")
+			return
+		}
+		if !g.paused {
+			err = fmt.Errorf("game isn't paused")
+			return
+		}
+
+		// moving times of the game forward, as if the pause hasn't happened
+		pausedFor := g.clock.Now().Sub(g.pausedAt)
+		g.startTime = g.startTime.Add(pausedFor)
+		for _, player := range g.players {
+			player.shiftTimes(pausedFor)
+		}
+
+		g.paused = false
+		g.durationTimer.resume(g.clock, g.pausedAt)
+		for _, scheduled := range g.scheduledTasks {
+			scheduled.timer.resume(g.clock, g.pausedAt)
+		}
+		g.broadcast(g.getResumedMessage())
+	}); loopErr != nil {
+		return loopErr
+	}
+	return err
+}
+
+// gameTime returns the current time of the game,
+// which doesn't move, while the game is paused.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) gameTime() time.Time {
+	if g.paused {
+		return g.pausedAt
+	}
+	return g.clock.Now()
+}
+
+// remainingTime returns the number of seconds until the game
+// finishes, or the game duration, if the game hasn't started.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) remainingTime() int32 {
+	if g.state == waitingState {
+		return g.config.duration
+	}
+	duration := time.Duration(g.config.duration) * time.Second
+	return secondsUntil(g.gameTime(), g.startTime.Add(duration))
+}
+
+// theftWaitTime returns the number of seconds until the next
+// theft, or the theft time, if the game hasn't started.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) theftWaitTime() int32 {
+	if g.theftTask == nil {
+		return g.config.theftTime
+	}
+	return secondsUntil(g.gameTime(), g.theftTask.timer.deadline)
+}
+
+// lotteryWaitTime returns the number of seconds until the player can play
+// the lottery again, or the lottery time, if the game hasn't started.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) lotteryWaitTime(player *player) int32 {
+	if g.state == waitingState {
+		return g.config.lotteryTime
+	}
+	lotteryTime := time.Duration(g.config.lotteryTime) * time.Second
+	return secondsUntil(g.gameTime(), player.lastLotteryTime.Add(lotteryTime))
+}
+
+// getState returns the remaining time of the game and the time until
+// the next theft and the next lottery of the player in seconds.
+func (g *game) getState(
+	userID userID,
+) (remainingTime int32, theftWaitTime int32, lotteryWaitTime int32, paused bool, err error) {
+	if loopErr := g.do(func() {
+		player, ok := g.players[userID]
+		if !ok {
+			err = fmt.Errorf("getState: invalid user id %v", userID)
+			return
+		}
+		remainingTime = g.remainingTime()
+		theftWaitTime = g.theftWaitTime()
+		lotteryWaitTime = g.lotteryWaitTime(player)
+		paused = g.paused
+	}); loopErr != nil {
+		return 0, 0, 0, false, loopErr
+	}
+	return remainingTime, theftWaitTime, lotteryWaitTime, paused, err
 }
 
-func (g *game) finish() {
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-	g.state = finishedState
+// schedule executes task in the event loop after duration d.
+// If the game finishes before, the task is done at the finish,
+// if settleAtFinish is true. Otherwise, it is cancelled.
+// While the game is paused, the task waits.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) schedule(d time.Duration, task func(), settleAtFinish bool) *scheduledTask {
+	scheduled := &scheduledTask{task: task, settleAtFinish: settleAtFinish}
+	scheduled.timer = &gameTimer{fire: func() {
+		// error is ignored, since tasks are settled or cancelled at the finish
+		g.do(func() {
+			// the timer could have fired just before the pause,
+			// it is started again, when the game resumes
+			if g.paused {
+				return
+			}
+			// the task could have been done or cancelled at the finish
+			if g.unschedule(scheduled) {
+				task()
+			}
+		})
+	}}
+	scheduled.timer.start(g.clock, d)
+	g.scheduledTasks = append(g.scheduledTasks, scheduled)
+	return scheduled
+}
 
-	go func() {
-		winnerUserID := g.getWinnerID()
-		msg := g.getFinishMessage(winnerUserID)
-		g.broadcast(msg)
-	}()
+// unschedule removes the task from scheduled tasks. It returns
+// false, if the task is not scheduled anymore.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) unschedule(scheduled *scheduledTask) bool {
+	for i, task := range g.scheduledTasks {
+		if task == scheduled {
+			g.scheduledTasks = append(g.scheduledTasks[:i], g.scheduledTasks[i+1:]...)
+			return true
+		}
+	}
+	return false
 }
 
-func (g *game) isFinished() bool {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
+// finish stops all timers of the game. Outstanding credits and deposits
+// are settled right away with full interest, as if they were due, so that
+// the final standings reflect them. Other scheduled tasks are cancelled.
+// The jackpot, which hasn't been won, goes back to the bank.
+// Players are told the id of the rematch lobby, if it is not empty.
+// The summary of the game is sent to players and returned.
+// The event loop of the game stops after the finish.
+func (g *game) finish(rematchGameID gameID) (summary *pb.GameSummary, err error) {
+	err = g.do(func() {
+		if g.durationTimer != nil {
+			g.durationTimer.timer.Stop()
+		}
+		for _, scheduled := range g.scheduledTasks {
+			scheduled.timer.timer.Stop()
+			if scheduled.settleAtFinish {
+				scheduled.task()
+			}
+		}
+		g.scheduledTasks = nil
+		g.bankPoints += g.jackpot
+		g.jackpot = 0
 
-	return g.state == finishedState
+		g.state = finishedState
+		summary = g.getSummary()
+		g.broadcast(g.getFinishMessage(summary, rematchGameID))
+	})
+	return summary, err
+}
+
+// getRoster returns usernames of the players in the game.
+func (g *game) getRoster() (roster map[userID]username, err error) {
+	err = g.do(func() {
+		roster = make(map[userID]username)
+		for _, player := range g.players {
+			roster[player.userID] = player.username
+		}
+	})
+	return roster, err
 }
 
 // useCredit returns "True" and empty string, if credit can be granted.
 // Otherwise, it will return "False" and explanation why credit has not
 // been granted.
-func (g *game) useCredit(userID userID, val int32) (bool, string, error) {
+func (g *game) useCredit(userID userID, val int32) (success bool, explanation string, err error) {
+	if loopErr := g.do(func() { success, explanation, err = g.handleCredit(userID, val) }); loopErr != nil {
+		return false, "", loopErr
+	}
+	return success, explanation, err
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) handleCredit(userID userID, val int32) (bool, string, error) {
+	if g.paused {
+		return false, "", errGamePaused
+	}
 	player, ok := g.players[userID]
 	if !ok {
 		return false, "", fmt.Errorf("there is no player with id %v in the game", userID)
 	}
 
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
 	// bank doesn't have enough points to give the credit
 	// NOTE: this check can be deleted to allow bank to go down a bit
 	// but in that case, we would need to check that the user doesn't borrow too much
@@ -249,15 +1088,16 @@ func (g *game) useCredit(userID userID, val int32) (bool, string, error) {
 
 	g.bankPoints -= val
 	player.points += val
+	player.statement.creditsTaken += val
 
-	time.AfterFunc(time.Duration(g.config.creditTime)*time.Second, func() {
-		g.returnCredit(userID, val)
-	})
+	creditTime := time.Duration(g.config.creditTime) * time.Second
+	credit := &loan{value: val, returnTime: g.clock.Now().Add(creditTime)}
+	player.credits = append(player.credits, credit)
+	g.schedule(creditTime, func() {
+		g.returnCredit(userID, credit)
+	}, true)
 
-	go func() {
-		msg := g.getUseCreditMessage(userID, val)
-		g.broadcast(msg)
-	}()
+	g.broadcast(g.getUseCreditMessage(userID, val))
 
 	return true, "", nil
 }
@@ -265,98 +1105,143 @@ func (g *game) useCredit(userID userID, val int32) (bool, string, error) {
 // useDeposit returns "True" and empty string, if deposit can be granted.
 // Otherwise, it will return "False" and explanation why deposit has not
 // been granted.
-func (g *game) useDeposit(userID userID, val int32) (bool, string, error) {
+func (g *game) useDeposit(userID userID, val int32) (success bool, explanation string, err error) {
+	if loopErr := g.do(func() { success, explanation, err = g.handleDeposit(userID, val) }); loopErr != nil {
+		return false, "", loopErr
+	}
+	return success, explanation, err
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) handleDeposit(userID userID, val int32) (bool, string, error) {
+	if g.paused {
+		return false, "", errGamePaused
+	}
 	player, ok := g.players[userID]
 	if !ok {
 		return false, "", fmt.Errorf("there is no player with id %v in the game", userID)
 	}
 
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
 	if player.points < val {
 		return false, "not allowed to deposit more than player has", nil
 	}
 
 	g.bankPoints += val
 	player.points -= val
+	player.statement.depositsMade += val
 
-	time.AfterFunc(time.Duration(g.config.depositTime)*time.Second, func() {
-		g.returnDeposit(userID, val)
-	})
+	depositTime := time.Duration(g.config.depositTime) * time.Second
+	deposit := &loan{value: val, returnTime: g.clock.Now().Add(depositTime)}
+	player.deposits = append(player.deposits, deposit)
+	g.schedule(depositTime, func() {
+		g.returnDeposit(userID, deposit)
+	}, true)
 
-	go func() {
-		msg := g.getUseDepositMessage(userID, val)
-		g.broadcast(msg)
-	}()
+	g.broadcast(g.getUseDepositMessage(userID, val))
 
 	return true, "", nil
 }
 
-func (g *game) returnCredit(userID userID, val int32) {
+// NOTE: has to be called in the event loop of the game.
+func (g *game) returnCredit(userID userID, credit *loan) {
 	player, ok := g.players[userID]
 	if !ok {
 		log.Printf("returnCredit has been called with user %v, who is not in this game", userID)
 		return
 	}
+	player.credits = removeLoan(player.credits, credit)
 
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
-	floatInterest := float64(val) * float64(g.config.creditInterest) / 100.0
-	interest := int32(math.Ceil(floatInterest))
-	valWithInterest := val + interest
+	interest := getNumberProportion(credit.value, g.config.creditInterest)
+	valWithInterest := credit.value + interest
 
 	g.bankPoints += valWithInterest
 	player.points -= valWithInterest
+	player.statement.creditInterestPaid += interest
 
-	go func() {
-		msg := g.getReturnCreditMessage(userID, valWithInterest)
-		g.broadcast(msg)
-	}()
+	g.broadcast(g.getReturnCreditMessage(userID, valWithInterest))
 }
 
-func (g *game) returnDeposit(userID userID, val int32) {
+// NOTE: has to be called in the event loop of the game.
+func (g *game) returnDeposit(userID userID, deposit *loan) {
 	player, ok := g.players[userID]
 	if !ok {
 		log.Printf("returnDeposit has been called with user %v, who is not in this game", userID)
 		return
 	}
+	player.deposits = removeLoan(player.deposits, deposit)
 
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
-	floatInterest := float64(val) * float64(g.config.depositInterest) / 100.0
-	interest := int32(math.Ceil(floatInterest))
-	valWithInterest := val + interest
+	interest := getNumberProportion(deposit.value, g.config.depositInterest)
+	valWithInterest := deposit.value + interest
 
 	g.bankPoints -= valWithInterest
 	player.points += valWithInterest
+	player.statement.depositInterestEarned += interest
 
-	go func() {
-		msg := g.getReturnDepositMessage(userID, valWithInterest)
-		g.broadcast(msg)
-	}()
+	g.broadcast(g.getReturnDepositMessage(userID, valWithInterest))
 }
 
-func (g *game) playLottery(userID userID, cellIndex int32) (bool, []int32, int32, error) {
+// commitLottery shuffles the lottery board for the next lottery of
+// the player and returns its commitment. If the board has already
+// been committed, the same commitment is returned.
+func (g *game) commitLottery(userID userID) (commitment string, err error) {
+	if loopErr := g.do(func() { commitment, err = g.handleCommitLottery(userID) }); loopErr != nil {
+		return "", loopErr
+	}
+	return commitment, err
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) handleCommitLottery(userID userID) (string, error) {
+	player, ok := g.players[userID]
+	if !ok {
+		errMsg := fmt.Sprintf("commitLottery has been called with user %v, who is not in this game", userID)
+		log.Printf(errMsg)
+		return "", fmt.Errorf(errMsg)
+	}
+
+	if player.lotteryCommitment == nil {
+		commitment, err := newLotteryCommitment(RandShuffle(g.random, g.lotteryCellValues))
+		if err != nil {
+			return "", err
+		}
+		player.lotteryCommitment = commitment
+	}
+	return player.lotteryCommitment.commitment, nil
+}
+
+// playLottery returns the committed board, if the player has requested
+// the commitment before the lottery. Otherwise, the board is shuffled
+// right away and returned commitment is nil.
+func (g *game) playLottery(
+	userID userID, cellIndex int32,
+) (success bool, cellValues []int32, winPoints int32, commitment *lotteryCommitment, err error) {
+	loopErr := g.do(func() {
+		success, cellValues, winPoints, commitment, err = g.handleLottery(userID, cellIndex)
+	})
+	if loopErr != nil {
+		return false, []int32{}, 0, nil, loopErr
+	}
+	return success, cellValues, winPoints, commitment, err
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) handleLottery(userID userID, cellIndex int32) (bool, []int32, int32, *lotteryCommitment, error) {
 	success := false
 	cellValues := []int32{}
 	winPoints := int32(0)
 
+	if g.paused {
+		return success, cellValues, winPoints, nil, errGamePaused
+	}
 	player, ok := g.players[userID]
 	if !ok {
 		errMsg := fmt.Sprintf("playLottery has been called with user %v, who is not in this game", userID)
 		log.Printf(errMsg)
-		return success, cellValues, winPoints, fmt.Errorf(errMsg)
+		return success, cellValues, winPoints, nil, fmt.Errorf(errMsg)
 	}
 
-	// locking for reads and writes
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
 	if !player.canPlayLottery(g.config.lotteryTime) {
-		timePassed := time.Since(player.lastLotteryTime).Seconds()
+		timePassed := g.clock.Now().Sub(player.lastLotteryTime).Seconds()
 		errMsg := fmt.Sprintf(
 			"please wait until next lottery time, only %f out of %d seconds have passed",
 			timePassed,
@@ -364,57 +1249,127 @@ func (g *game) playLottery(userID userID, cellIndex int32) (bool, []int32, int32
 		)
 		log.Println(errMsg)
 		// err is nil, but success is false according to game logic
-		return success, cellValues, winPoints, nil
+		return success, cellValues, winPoints, nil, nil
+	}
+
+	ticketPrice := g.config.lotteryTicketPrice
+	if player.points < ticketPrice {
+		errMsg := fmt.Sprintf(
+			"player has %d points, but the lottery ticket costs %d points", player.points, ticketPrice,
+		)
+		log.Println(errMsg)
+		return success, cellValues, winPoints, nil, fmt.Errorf(errMsg)
 	}
 
 	// all conditions for lottery are correct
 	// first, calculate lottery values
-	cellValues = RandShuffle(g.lotteryCellValues)
-	winPoints = cellValues[cellIndex-1]
+	commitment := player.lotteryCommitment
+	player.lotteryCommitment = nil
+	if commitment != nil {
+		cellValues = commitment.cellValues
+	} else {
+		cellValues = RandShuffle(g.random, g.lotteryCellValues)
+	}
 	success = true
 
 	// record that player have just played lottery
 	player.updateLastLotteryTime()
 
-	// only if player won some amount
-	if success && winPoints >= 0 {
-		// add points to player
-		player.points += winPoints
+	// paying for the ticket, a share of which goes to the jackpot
+	jackpotContribution := getNumberProportion(ticketPrice, g.config.lotteryJackpotShare)
+	player.points -= ticketPrice
+	player.statement.lotteryTicketsPaid += ticketPrice
+	g.jackpot += jackpotContribution
+	g.bankPoints += ticketPrice - jackpotContribution
+
+	jackpotWon := cellValues[cellIndex-1] == LotteryJackpotPayout
+	if jackpotWon {
+		winPoints = g.jackpot
+		g.jackpot = 0
+	} else {
+		winPoints = cellValues[cellIndex-1]
 		g.bankPoints -= winPoints
-
-		go func() {
-			msg := g.getLotteryMessage(player.userID, winPoints)
-			g.broadcast(msg)
-		}()
 	}
+	player.points += winPoints
+	player.statement.lotteryWinnings += winPoints
 
-	return success, cellValues, winPoints, nil
+	g.broadcast(g.getLotteryMessage(player.userID, winPoints, ticketPrice, jackpotWon))
+
+	return success, cellValues, winPoints, commitment, nil
 }
 
-func (g *game) doGenerateQuestion(userID userID, bidPoints int32) (questionID, string, []string, error) {
+func (g *game) doGenerateQuestion(
+	userID userID, bidPoints int32, filter QuestionFilter,
+) (questionID, *Question, []string, error) {
 	questionID := questionID("")
-	question := ""
+	var question *Question
 	answers := []string{}
 
-	player, ok := g.players[userID]
-	if !ok {
-		errMsg := fmt.Sprintf("doGenerateQuestion has been called with user %v, who is not in this game", userID)
-		log.Printf(errMsg)
-		return questionID, question, answers, fmt.Errorf(errMsg)
+	// checking in advance, so that the question is not wasted
+	var checkErr error
+	if err := g.do(func() {
+		player, ok := g.players[userID]
+		if g.paused {
+			checkErr = errGamePaused
+		} else if !ok {
+			errMsg := fmt.Sprintf("doGenerateQuestion has been called with user %v, who is not in this game", userID)
+			log.Printf(errMsg)
+			checkErr = fmt.Errorf(errMsg)
+		} else if player.points < bidPoints {
+			checkErr = fmt.Errorf("player has less points than bid amount")
+		}
+	}); err != nil {
+		return questionID, question, answers, err
+	}
+	if checkErr != nil {
+		return questionID, question, answers, checkErr
+	}
+
+	// the question is taken outside of the event loop, since it may
+	// require a network request to the question provider
+	generatedQuestion, err := g.questionCache.take(filter, g.isQuestionServed)
+	if err != nil {
+		return questionID, question, answers, fmt.Errorf("failed to get question: %v", err)
 	}
 
-	// acquiring write lock
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
+	if loopErr := g.do(func() {
+		questionID, answers, err = g.handleGenerateQuestion(userID, bidPoints, filter, generatedQuestion)
+	}); loopErr != nil {
+		return questionID, question, answers, loopErr
+	}
+	if err != nil {
+		return questionID, question, answers, err
+	}
+	return questionID, generatedQuestion, answers, nil
+}
 
+// NOTE: has to be called in the event loop of the game.
+func (g *game) handleGenerateQuestion(
+	userID userID, bidPoints int32, filter QuestionFilter, question *Question,
+) (questionID, []string, error) {
+	if g.paused {
+		return "", nil, errGamePaused
+	}
+	player := g.players[userID]
 	if player.points < bidPoints {
-		return questionID, question, answers, fmt.Errorf("player has less points than bid amount")
+		return "", nil, fmt.Errorf("player has less points than bid amount")
 	}
 
-	questionID, question, answers, err := player.generateQuestion(bidPoints)
+	questionID, answers, err := player.generateQuestion(
+		bidPoints,
+		question,
+		g.config.questionAnswerTime,
+		g.config.questionWinPercentages[filter.Difficulty],
+		g.random,
+	)
 	if err != nil {
-		return questionID, question, answers, err
+		return "", nil, err
 	}
+	g.servedQuestions[question.Text] = true
+
+	g.schedule(time.Duration(g.config.questionAnswerTime)*time.Second, func() {
+		g.expireQuestion(userID, questionID)
+	}, false)
 
 	// subtracting bid points from player
 	g.bankPoints += bidPoints
@@ -422,55 +1377,134 @@ func (g *game) doGenerateQuestion(userID userID, bidPoints int32) (questionID, s
 
 	// we do not broadcast that question was generated
 
-	return questionID, question, answers, nil
+	return questionID, answers, nil
 }
 
 func (g *game) doAnswerQuestion(
 	userID userID, questionID questionID, userAnswer int32,
-) (bool, int32, int32, error) {
+) (answerIsCorrect bool, correctAnswer int32, winPoints int32, expired bool, err error) {
+	loopErr := g.do(func() {
+		answerIsCorrect, correctAnswer, winPoints, expired, err = g.handleAnswerQuestion(
+			userID, questionID, userAnswer,
+		)
+	})
+	if loopErr != nil {
+		return false, 0, 0, false, loopErr
+	}
+	return answerIsCorrect, correctAnswer, winPoints, expired, err
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) handleAnswerQuestion(
+	userID userID, questionID questionID, userAnswer int32,
+) (bool, int32, int32, bool, error) {
 	answerIsCorrect := false
 	correctAnswer := int32(0)
 	bidPoints := int32(0)
 	winPoints := int32(0)
+	expired := false
 
+	if g.paused {
+		return answerIsCorrect, correctAnswer, winPoints, expired, errGamePaused
+	}
 	player, ok := g.players[userID]
 	if !ok {
 		errMsg := fmt.Sprintf("doAnswerQuestion has been called with user %v, who is not in this game", userID)
 		log.Printf(errMsg)
-		return answerIsCorrect, correctAnswer, winPoints, fmt.Errorf(errMsg)
+		return answerIsCorrect, correctAnswer, winPoints, expired, fmt.Errorf(errMsg)
 	}
 
-	// acquiring write lock
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
-	answerIsCorrect, correctAnswer, bidPoints, err := player.answerQuestion(questionID, userAnswer)
+	qInfo, expired, err := player.answerQuestion(questionID)
 	if err != nil {
-		return answerIsCorrect, correctAnswer, winPoints, err
+		return answerIsCorrect, correctAnswer, winPoints, expired, err
+	}
+	correctAnswer = qInfo.correctAnswer
+	bidPoints = qInfo.bidPoints
+	if qInfo.expired {
+		// the bid has been forfeited and others
+		// have been notified at the deadline
+		return answerIsCorrect, correctAnswer, winPoints, expired, nil
 	}
 
+	winFraction := float64(0)
+	if !expired {
+		winFraction = qInfo.winFraction(userAnswer)
+	}
+	answerIsCorrect = winFraction > 0
+
 	if answerIsCorrect {
-		floatWinPoints := float64(bidPoints) * float64(g.config.questionWinPercentage) / 100.0
+		floatWinPoints := float64(bidPoints) * float64(qInfo.winPercentage) / 100.0 * winFraction
 		winPoints = int32(math.Ceil(floatWinPoints))
 	} else {
 		winPoints = int32(0)
 	}
 
+	// the bid has been withdrawn, when the question was generated
+	if winPoints > bidPoints {
+		player.statement.questionBidsWon += winPoints - bidPoints
+	} else {
+		player.statement.questionBidsLost += bidPoints - winPoints
+	}
+
 	if winPoints >= 0 {
 		g.bankPoints -= winPoints
 		player.points += winPoints
 
-		go func() {
-			msg := g.getAnswerQuestionMessage(userID, answerIsCorrect, bidPoints, winPoints)
-			g.broadcast(msg)
-		}()
+		g.broadcast(g.getAnswerQuestionMessage(userID, answerIsCorrect, bidPoints, winPoints, expired))
+	}
+
+	return answerIsCorrect, correctAnswer, winPoints, expired, nil
+}
+
+// getQuestionAnswerRange returns minimum and maximum answer,
+// which player can give to the question.
+func (g *game) getQuestionAnswerRange(userID userID, questionID questionID) (answerMin int32, answerMax int32, err error) {
+	loopErr := g.do(func() {
+		player, ok := g.players[userID]
+		if !ok {
+			err = fmt.Errorf("there is no player with id %v in the game", userID)
+			return
+		}
+		answerMin, answerMax, err = player.answerRange(questionID)
+	})
+	if loopErr != nil {
+		return 0, 0, loopErr
+	}
+	return answerMin, answerMax, err
+}
+
+// expireQuestion forfeits the bid of the question, if the player
+// hasn't answered it in time. Bid points already belong to the bank,
+// so the question is only marked as expired and others are notified.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) expireQuestion(userID userID, questionID questionID) {
+	player, ok := g.players[userID]
+	if !ok {
+		log.Printf("expireQuestion has been called with user %v, who is not in this game", userID)
+		return
 	}
 
-	return answerIsCorrect, correctAnswer, winPoints, nil
+	bidPoints, expired := player.expireQuestion(questionID)
+	if !expired {
+		// the question has already been answered
+		return
+	}
+	player.statement.questionBidsLost += bidPoints
+
+	g.broadcast(g.getAnswerQuestionMessage(userID, false, bidPoints, 0, true))
+}
+
+// isQuestionServed returns true, if the question has been generated
+// in this game. The question is considered served in finished games.
+func (g *game) isQuestionServed(question *Question) bool {
+	served := true
+	g.do(func() {
+		served = g.servedQuestions[question.Text]
+	})
+	return served
 }
 
-// The calling function has to acquire at least read lock
-// for accurate reading of player points.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) printPlayersPoints(preMsg string) {
 	log.Println(preMsg)
 	for _, player := range g.players {
@@ -478,16 +1512,8 @@ func (g *game) printPlayersPoints(preMsg string) {
 	}
 }
 
+// NOTE: has to be called in the event loop of the game.
 func (g *game) doTheft() {
-	g.mutex.Lock()
-	defer g.mutex.Unlock()
-
-	// this function executes over and over again
-	// so we need to stop it after the game is finished
-	if g.state == finishedState {
-		return
-	}
-
 	var userIDs []userID
 	var theftAmounts []int32
 
@@ -502,6 +1528,7 @@ func (g *game) doTheft() {
 		if theftAmount > 0 {
 			player.points -= theftAmount // point deduction from player
 			g.bankPoints += theftAmount  // add them to bank
+			player.statement.theftLosses += theftAmount
 
 			userIDs = append(userIDs, userID)
 			theftAmounts = append(theftAmounts, theftAmount)
@@ -509,58 +1536,153 @@ func (g *game) doTheft() {
 	}
 	g.printPlayersPoints("Players' points AFTER theft")
 
-	go func() {
-		msg := g.getTheftMessage(userIDs, theftAmounts)
-		g.broadcast(msg)
-		log.Printf("Theft happened as follows:\n%v", msg)
-	}()
+	msg := g.getTheftMessage(userIDs, theftAmounts)
+	g.broadcast(msg)
+	log.Printf("Theft happened as follows:\n%v", msg)
 
-	time.AfterFunc(time.Duration(g.config.theftTime)*time.Second, func() {
-		g.doTheft()
-	})
+	// theft is repeated until the game finishes
+	g.theftTask = g.schedule(time.Duration(g.config.theftTime)*time.Second, g.doTheft, false)
 }
 
-func (g *game) setPlayerStream(userID userID, stream pb.Game_StreamServer) error {
-	g.mutex.Lock() /* WRITE lock for player.setStream */
-	defer g.mutex.Unlock()
+// setPlayerStream creates the subscriber, to which events are queued
+// for the player. Events after lastSeenSequence are returned, so that
+// the player doesn't miss anything, if the stream is reopened. They
+// have to be sent before the events from the subscriber.
+func (g *game) setPlayerStream(
+	userID userID, lastSeenSequence int64,
+) (missedEvents []*pb.StreamResponse, subscriber *subscriber, err error) {
+	if loopErr := g.do(func() {
+		player, ok := g.players[userID]
+		if !ok {
+			err = fmt.Errorf("setPlayerStream: invalid user id %v", userID)
+			return
+		}
+		if lastSeenSequence < 0 || lastSeenSequence > g.sequence {
+			err = fmt.Errorf(
+				"last seen sequence has to be from 0 to %d, received: %d", g.sequence, lastSeenSequence,
+			)
+			return
+		}
 
-	player, ok := g.players[userID]
-	if !ok {
-		return fmt.Errorf("setPlayerStream: invalid user id %v", userID)
+		missedEvents = g.events[lastSeenSequence:]
+		subscriber = newSubscriber(g.config.streamQueueSize)
+		player.setSubscriber(subscriber)
+	}); loopErr != nil {
+		return nil, nil, loopErr
 	}
+	return missedEvents, subscriber, err
+}
 
-	player.setStream(stream)
-	return nil
+// resumePlayer finds the player by the reconnect token and creates
+// the subscriber, to which next events are queued for the player.
+// The returned snapshot has to be sent before the events from the subscriber.
+func (g *game) resumePlayer(
+	reconnectToken string,
+) (userID userID, snapshot *pb.StreamResponse, subscriber *subscriber, err error) {
+	if loopErr := g.do(func() {
+		var player *player
+		for _, p := range g.players {
+			if p.reconnectToken == reconnectToken {
+				player = p
+			}
+		}
+		if player == nil {
+			err = fmt.Errorf("invalid reconnect token")
+			return
+		}
+
+		userID = player.userID
+		snapshot = g.getSnapshotMessage(player)
+		subscriber = newSubscriber(g.config.streamQueueSize)
+		player.setSubscriber(subscriber)
+	}); loopErr != nil {
+		return "", nil, nil, loopErr
+	}
+	return userID, snapshot, subscriber, err
+}
+
+// addSpectator creates the subscriber, to which events are queued
+// for the spectator. Events after lastSeenSequence are returned in
+// the same way as in setPlayerStream.
+func (g *game) addSpectator(
+	lastSeenSequence int64,
+) (missedEvents []*pb.StreamResponse, subscriber *subscriber, err error) {
+	if loopErr := g.do(func() {
+		if lastSeenSequence < 0 || lastSeenSequence > g.sequence {
+			err = fmt.Errorf(
+				"last seen sequence has to be from 0 to %d, received: %d", g.sequence, lastSeenSequence,
+			)
+			return
+		}
+
+		missedEvents = g.events[lastSeenSequence:]
+		subscriber = newSubscriber(g.config.streamQueueSize)
+		g.spectators[subscriber] = true
+	}); loopErr != nil {
+		return nil, nil, loopErr
+	}
+	return missedEvents, subscriber, err
 }
 
-// Broadcast sends some event to all users in the game.
+// unsubscribe removes the subscriber of the player or the spectator,
+// e.g. when the stream is closed by the client. Nothing is done, if
+// the player has another subscriber already.
+func (g *game) unsubscribe(userID userID, subscriber *subscriber) {
+	// error is ignored, since nothing is sent after the finish
+	g.do(func() {
+		delete(g.spectators, subscriber)
+		player, ok := g.players[userID]
+		if ok && player.subscriber == subscriber {
+			player.subscriber = nil
+		}
+	})
+}
+
+// broadcast sends some event to all users and spectators in the game.
+// Each event gets the next sequence number and is
+// recorded, so that it can be replayed later.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) broadcast(response *pb.StreamResponse) {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-	for userID, player := range g.players {
-		stream := player.stream
-		// WARNING: this is a dirty workaround around the problem
-		// that start/deposit/etc handlers may be called before
-		if stream == nil {
+	g.sequence++
+	response.Sequence = g.sequence
+	g.events = append(g.events, response)
+	for _, player := range g.players {
+		// start/deposit/etc handlers may be called
+		// before the player opens the stream
+		if player.subscriber == nil {
 			continue
 		}
-		if err := stream.Send(response); err != nil {
-			log.Printf("Could not send event to %v in game %v: %v\n", userID, g.gameID, err)
-			continue
+		if !g.send(player.subscriber, string(player.userID), response) {
+			player.subscriber = nil
 		}
-
-		// if sent message is Start, then player marked as notified about start
-		if reflect.TypeOf(response.Event) == reflect.TypeOf(pb.StreamResponse_Start_{}) {
-			player.gameStartNotified = true
+	}
+	for subscriber := range g.spectators {
+		if !g.send(subscriber, "spectator", response) {
+			delete(g.spectators, subscriber)
 		}
+	}
+}
 
-		// if game is in active state and the player has not been notified about start,
-		// then notify player about start and mark player as notified
-		if g.state == activeState && !player.gameStartNotified {
-			stream.Send(g.getStartMessage())
-			player.gameStartNotified = true
-		}
+// send queues the event for the subscriber of the player or the spectator.
+// If the queue is full, the slow stream policy of the config is applied.
+// It returns false, if the subscriber has been disconnected.
+// NOTE: has to be called in the event loop of the game.
+func (g *game) send(subscriber *subscriber, owner string, response *pb.StreamResponse) bool {
+	if subscriber.enqueue(response) {
+		return true
 	}
+
+	switch g.config.slowStreamPolicy {
+	case dropSlowStreamPolicy:
+		log.Printf("Event %d is dropped for slow stream of %v\n", response.Sequence, owner)
+	case disconnectSlowStreamPolicy:
+		log.Printf("Slow stream of %v is disconnected at event %d\n", owner, response.Sequence)
+		subscriber.disconnect(
+			"stream is too slow, reopen it with the last seen sequence to receive missed events",
+		)
+		return false
+	}
+	return true
 }
 
 func (g *game) getBankAsPBPlayer() *pb.Player {
@@ -571,11 +1693,7 @@ func (g *game) getBankAsPBPlayer() *pb.Player {
 	}
 }
 
-// WARNING: This function doesn't use any locks (in order not to spawn
-// another goroutine). So make sure that goroutine, which calls this function
-// uses at least read-lock.
-// Read-lock is needed since g.players are read and the state of each player
-// is read.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) getPBPlayersWithBank() []*pb.Player {
 	var players []*pb.Player
 	for _, player := range g.players {
@@ -585,11 +1703,8 @@ func (g *game) getPBPlayersWithBank() []*pb.Player {
 	return players
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) getJoinMessage(player *player) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-
 	pbPlayer := player.toPBPlayer()
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Join_{
@@ -602,56 +1717,208 @@ func (g *game) getJoinMessage(player *player) *pb.StreamResponse {
 }
 
 // This function can be called from anywhere, as it doesn't
-// refer to the state of the game and does not use any locks.
-func (g *game) getLeaveMessage(userID userID) *pb.StreamResponse {
+// refer to the state of the game.
+func (g *game) getLeaveMessage(userID userID, kicked bool) *pb.StreamResponse {
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Leave_{
 			Leave: &pb.StreamResponse_Leave{
 				UserId: string(userID),
+				Kicked: kicked,
+			},
+		},
+	}
+	return res
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getLobbyStateMessage() *pb.StreamResponse {
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_LobbyState_{
+			LobbyState: &pb.StreamResponse_LobbyState{
+				HostUserId: string(g.hostID),
+				Locked:     g.locked,
 			},
 		},
 	}
 	return res
 }
 
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getConfigMessage() *pb.StreamResponse {
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Config{
+			Config: g.config.toPB(),
+		},
+	}
+	return res
+}
+
 // This function can be called from anywhere, as it doesn't
-// refer to the state of the game and does not use any locks.
+// refer to the state of the game.
+func (g *game) getCountdownMessage(remainingTime int32, cancelled bool) *pb.StreamResponse {
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Countdown_{
+			Countdown: &pb.StreamResponse_Countdown{
+				RemainingTime: remainingTime,
+				Cancelled:     cancelled,
+			},
+		},
+	}
+	return res
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getPausedMessage() *pb.StreamResponse {
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Paused_{
+			Paused: &pb.StreamResponse_Paused{
+				RemainingTime: g.remainingTime(),
+			},
+		},
+	}
+	return res
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getResumedMessage() *pb.StreamResponse {
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Resumed_{
+			Resumed: &pb.StreamResponse_Resumed{
+				RemainingTime: g.remainingTime(),
+			},
+		},
+	}
+	return res
+}
+
+// NOTE: has to be called in the event loop of the game.
 func (g *game) getStartMessage() *pb.StreamResponse {
+	duration := time.Duration(g.config.duration) * time.Second
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Start_{
-			Start: &pb.StreamResponse_Start{},
+			Start: &pb.StreamResponse_Start{
+				StartTime: unixMillis(g.startTime),
+				EndTime:   unixMillis(g.startTime.Add(duration)),
+			},
 		},
 	}
 	return res
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
-func (g *game) getFinishMessage(winnerUserID userID) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getFinishMessage(summary *pb.GameSummary, rematchGameID gameID) *pb.StreamResponse {
 	players := g.getPBPlayersWithBank()
+	// there is no single winner on a tie
+	var winnerUserID string
+	if len(summary.WinnerUserIds) == 1 {
+		winnerUserID = summary.WinnerUserIds[0]
+	}
+	var rematchTime int32
+	if rematchGameID != "" {
+		rematchTime = g.config.rematchTime
+	}
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Finish_{
 			Finish: &pb.StreamResponse_Finish{
-				Players:      players,
-				WinnerUserId: string(winnerUserID),
+				Players:       players,
+				WinnerUserId:  winnerUserID,
+				RandomSeed:    g.randomSeed,
+				RematchGameId: string(rematchGameID),
+				RematchTime:   rematchTime,
+				Summary:       summary,
 			},
 		},
 	}
 	return res
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
-func (g *game) getUseCreditMessage(userID userID, val int32) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
+// unixMillis returns the time in milliseconds since the unix epoch.
+func unixMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// secondsUntil returns the number of seconds from now until
+// the given time rounded up, or 0 if the time has passed.
+func secondsUntil(now time.Time, t time.Time) int32 {
+	if !t.After(now) {
+		return 0
+	}
+	return int32(math.Ceil(t.Sub(now).Seconds()))
+}
+
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getSnapshotMessage(player *player) *pb.StreamResponse {
+	now := g.gameTime()
+	started := g.state != waitingState
+
+	startCountdown := g.remainingCountdown()
+
+	var credits []*pb.StreamResponse_Snapshot_Loan
+	for _, credit := range player.credits {
+		credits = append(credits, &pb.StreamResponse_Snapshot_Loan{
+			Value:             credit.value,
+			ValueWithInterest: credit.value + getNumberProportion(credit.value, g.config.creditInterest),
+			ReturnTime:        secondsUntil(now, credit.returnTime),
+		})
+	}
+	var deposits []*pb.StreamResponse_Snapshot_Loan
+	for _, deposit := range player.deposits {
+		deposits = append(deposits, &pb.StreamResponse_Snapshot_Loan{
+			Value:             deposit.value,
+			ValueWithInterest: deposit.value + getNumberProportion(deposit.value, g.config.depositInterest),
+			ReturnTime:        secondsUntil(now, deposit.returnTime),
+		})
+	}
+	var questions []*pb.StreamResponse_Snapshot_OutstandingQuestion
+	for questionID, qInfo := range player.questions {
+		if qInfo.expired {
+			continue
+		}
+		questions = append(questions, &pb.StreamResponse_Snapshot_OutstandingQuestion{
+			QuestionId: string(questionID),
+			Question:   qInfo.text,
+			Answers:    qInfo.answers,
+			Type:       questionTypesToPB[qInfo.questionType],
+			AnswerMin:  qInfo.answerMin,
+			AnswerMax:  qInfo.answerMax,
+			BidPoints:  qInfo.bidPoints,
+			AnswerTime: secondsUntil(now, qInfo.createdAt.Add(qInfo.answerTime)),
+		})
+	}
+
+	res := &pb.StreamResponse{
+		Event: &pb.StreamResponse_Snapshot_{
+			Snapshot: &pb.StreamResponse_Snapshot{
+				UserId:          string(player.userID),
+				Username:        string(player.username),
+				Started:         started,
+				Players:         g.getPBPlayersWithBank(),
+				Jackpot:         g.jackpot,
+				Credits:         credits,
+				Deposits:        deposits,
+				Questions:       questions,
+				LotteryWaitTime: g.lotteryWaitTime(player),
+				RemainingTime:   g.remainingTime(),
+				HostUserId:      string(g.hostID),
+				Locked:          g.locked,
+				StartCountdown:  startCountdown,
+				Paused:          g.paused,
+			},
+		},
+		Sequence: g.sequence,
+	}
+	return res
+}
 
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getUseCreditMessage(userID userID, val int32) *pb.StreamResponse {
 	players := g.getPBPlayersWithBank()
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
+				Time:    unixMillis(g.clock.Now()),
 				Event: &pb.StreamResponse_Transaction_UseCredit_{
 					UseCredit: &pb.StreamResponse_Transaction_UseCredit{
 						UserId: string(userID),
@@ -664,16 +1931,15 @@ func (g *game) getUseCreditMessage(userID userID, val int32) *pb.StreamResponse
 	return res
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) getUseDepositMessage(userID userID, val int32) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-
 	players := g.getPBPlayersWithBank()
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
+				Time:    unixMillis(g.clock.Now()),
 				Event: &pb.StreamResponse_Transaction_UseDeposit_{
 					UseDeposit: &pb.StreamResponse_Transaction_UseDeposit{
 						UserId: string(userID),
@@ -686,16 +1952,15 @@ func (g *game) getUseDepositMessage(userID userID, val int32) *pb.StreamResponse
 	return res
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) getReturnCreditMessage(userID userID, valWithInterest int32) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-
 	players := g.getPBPlayersWithBank()
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
+				Time:    unixMillis(g.clock.Now()),
 				Event: &pb.StreamResponse_Transaction_ReturnCredit_{
 					ReturnCredit: &pb.StreamResponse_Transaction_ReturnCredit{
 						UserId: string(userID),
@@ -708,16 +1973,15 @@ func (g *game) getReturnCreditMessage(userID userID, valWithInterest int32) *pb.
 	return res
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) getReturnDepositMessage(userID userID, valWithInterest int32) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-
 	players := g.getPBPlayersWithBank()
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
+				Time:    unixMillis(g.clock.Now()),
 				Event: &pb.StreamResponse_Transaction_ReturnDeposit_{
 					ReturnDeposit: &pb.StreamResponse_Transaction_ReturnDeposit{
 						UserId: string(userID),
@@ -730,11 +1994,8 @@ func (g *game) getReturnDepositMessage(userID userID, valWithInterest int32) *pb
 	return res
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) getTheftMessage(userIDs []userID, theftAmounts []int32) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-
 	var robbedPlayers []*pb.StreamResponse_Transaction_Theft_RobbedPlayer
 	for ind := range userIDs {
 		robbedPlayer := &pb.StreamResponse_Transaction_Theft_RobbedPlayer{
@@ -749,6 +2010,8 @@ func (g *game) getTheftMessage(userIDs []userID, theftAmounts []int32) *pb.Strea
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
+				Time:    unixMillis(g.clock.Now()),
 				Event: &pb.StreamResponse_Transaction_Theft_{
 					Theft: &pb.StreamResponse_Transaction_Theft{
 						RobbedPlayers: robbedPlayers,
@@ -760,20 +2023,23 @@ func (g *game) getTheftMessage(userIDs []userID, theftAmounts []int32) *pb.Strea
 	return res
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
-func (g *game) getLotteryMessage(userID userID, val int32) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-
+// NOTE: has to be called in the event loop of the game.
+func (g *game) getLotteryMessage(
+	userID userID, val int32, ticketPrice int32, jackpotWon bool,
+) *pb.StreamResponse {
 	players := g.getPBPlayersWithBank()
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
+				Time:    unixMillis(g.clock.Now()),
 				Event: &pb.StreamResponse_Transaction_Lottery_{
 					Lottery: &pb.StreamResponse_Transaction_Lottery{
-						UserId: string(userID),
-						Value:  val,
+						UserId:      string(userID),
+						Value:       val,
+						TicketPrice: ticketPrice,
+						JackpotWon:  jackpotWon,
 					},
 				},
 			},
@@ -782,24 +2048,24 @@ func (g *game) getLotteryMessage(userID userID, val int32) *pb.StreamResponse {
 	return res
 }
 
-// As this function uses Readlock, it has to be spawned in a separate goroutine.
+// NOTE: has to be called in the event loop of the game.
 func (g *game) getAnswerQuestionMessage(
-	userID userID, answerIsCorrect bool, bidPoints int32, winPoints int32,
+	userID userID, answerIsCorrect bool, bidPoints int32, winPoints int32, expired bool,
 ) *pb.StreamResponse {
-	g.mutex.RLock()
-	defer g.mutex.RUnlock()
-
 	players := g.getPBPlayersWithBank()
 	res := &pb.StreamResponse{
 		Event: &pb.StreamResponse_Transaction_{
 			Transaction: &pb.StreamResponse_Transaction{
 				Players: players,
+				Jackpot: g.jackpot,
+				Time:    unixMillis(g.clock.Now()),
 				Event: &pb.StreamResponse_Transaction_Question_{
 					Question: &pb.StreamResponse_Transaction_Question{
 						UserId:          string(userID),
 						AnswerIsCorrect: answerIsCorrect,
 						BidPoints:       bidPoints,
 						WinPoints:       winPoints,
+						Expired:         expired,
 					},
 				},
 			},
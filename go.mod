@@ -8,4 +8,5 @@ require (
 	github.com/stretchr/testify v1.6.1
 	google.golang.org/grpc v1.33.0
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
 )
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"strings"
+)
+
+// Join codes are short, so that they can be dictated in class.
+// Similar characters (0 and O, 1 and I) are not used.
+const (
+	joinCodeLength  = 6
+	joinCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// newJoinCode returns a random join code, which isn't used yet.
+// "isUsed" is called to check the generated codes.
+func newJoinCode(isUsed func(string) bool) string {
+	for {
+		code := RandStringWithCharset(joinCodeLength, joinCodeCharset)
+		if !isUsed(code) {
+			return code
+		}
+	}
+}
+
+// rematch is the lobby, which players of the finished
+// game can join during the rematch time.
+type rematch struct {
+	lobby  *game
+	roster map[userID]username // players of the finished game
+	joined map[userID]bool     // players, who have joined the lobby
+}
+
+// normalizeJoinCode makes join codes case-insensitive.
+func normalizeJoinCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// size of the random salt in bytes, which is
+// hashed together with the committed lottery board
+const lotterySaltSize = 16
+
+// lotteryCommitment is the shuffled lottery board, which is
+// committed before the player chooses the cell.
+type lotteryCommitment struct {
+	cellValues []int32
+	salt       []byte
+	commitment string
+}
+
+func newLotteryCommitment(cellValues []int32) (*lotteryCommitment, error) {
+	salt := make([]byte, lotterySaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate lottery salt: %v", err)
+	}
+	return &lotteryCommitment{
+		cellValues: cellValues,
+		salt:       salt,
+		commitment: LotteryCommitment(salt, cellValues),
+	}, nil
+}
+
+// LotteryCommitment returns the hex-encoded sha256 hash of the salt
+// followed by the cell values, each encoded as 4 bytes in big endian.
+func LotteryCommitment(salt []byte, cellValues []int32) string {
+	hash := sha256.New()
+	hash.Write(salt)
+	for _, value := range cellValues {
+		binary.Write(hash, binary.BigEndian, value)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// VerifyLottery checks that the revealed lottery board and salt
+// match the commitment, which has been published before the
+// cell was chosen, and that the win is the value of chosen cell.
+// The size of the jackpot isn't committed, so any win is accepted
+// for the jackpot cell. cellIndex starts from 1.
+func VerifyLottery(commitment string, salt []byte, cellValues []int32, cellIndex int32, winPoints int32) error {
+	if LotteryCommitment(salt, cellValues) != commitment {
+		return fmt.Errorf("revealed board %v doesn't match commitment %s", cellValues, commitment)
+	}
+	if cellIndex < 1 || int(cellIndex) > len(cellValues) {
+		return fmt.Errorf("cell index %d is outside of the board with %d cells", cellIndex, len(cellValues))
+	}
+	if cellValues[cellIndex-1] == LotteryJackpotPayout {
+		if winPoints < 0 {
+			return fmt.Errorf("jackpot win cannot be negative, got %d", winPoints)
+		}
+		return nil
+	}
+	if cellValues[cellIndex-1] != winPoints {
+		return fmt.Errorf(
+			"win points %d are not equal to the value of cell %d (%d)",
+			winPoints, cellIndex, cellValues[cellIndex-1],
+		)
+	}
+	return nil
+}
@@ -33,6 +33,107 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type QuestionDifficulty int32
+
+const (
+	QuestionDifficulty_EASY   QuestionDifficulty = 0
+	QuestionDifficulty_MEDIUM QuestionDifficulty = 1
+	QuestionDifficulty_HARD   QuestionDifficulty = 2
+)
+
+// Enum value maps for QuestionDifficulty.
+var (
+	QuestionDifficulty_name = map[int32]string{
+		0: "EASY",
+		1: "MEDIUM",
+		2: "HARD",
+	}
+	QuestionDifficulty_value = map[string]int32{
+		"EASY":   0,
+		"MEDIUM": 1,
+		"HARD":   2,
+	}
+)
+
+func (x QuestionDifficulty) Enum() *QuestionDifficulty {
+	p := new(QuestionDifficulty)
+	*p = x
+	return p
+}
+
+func (x QuestionDifficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionDifficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[0].Descriptor()
+}
+
+func (QuestionDifficulty) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[0]
+}
+
+func (x QuestionDifficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionDifficulty.Descriptor instead.
+func (QuestionDifficulty) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{0}
+}
+
+type QuestionType int32
+
+const (
+	// 4 answers, one of which is correct
+	QuestionType_MULTIPLE QuestionType = 0
+	// 2 answers (true and false), one of which is correct
+	QuestionType_BOOLEAN QuestionType = 1
+	// no answers, player has to estimate the number from the answer range
+	QuestionType_NUMERIC QuestionType = 2
+)
+
+// Enum value maps for QuestionType.
+var (
+	QuestionType_name = map[int32]string{
+		0: "MULTIPLE",
+		1: "BOOLEAN",
+		2: "NUMERIC",
+	}
+	QuestionType_value = map[string]int32{
+		"MULTIPLE": 0,
+		"BOOLEAN":  1,
+		"NUMERIC":  2,
+	}
+)
+
+func (x QuestionType) Enum() *QuestionType {
+	p := new(QuestionType)
+	*p = x
+	return p
+}
+
+func (x QuestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_game_proto_enumTypes[1].Descriptor()
+}
+
+func (QuestionType) Type() protoreflect.EnumType {
+	return &file_game_proto_enumTypes[1]
+}
+
+func (x QuestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuestionType.Descriptor instead.
+func (QuestionType) EnumDescriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{1}
+}
+
 type Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -96,16 +197,25 @@ func (x *Player) GetPoints() int32 {
 	return 0
 }
 
-type JoinRequest struct {
+// Final standings of the finished game with the statement of each
+// player. All values are in points. Credits and deposits are settled
+// at the finish, so the final points of the player are its initial
+// points - credit_interest_paid + deposit_interest_earned - theft_losses
+// - lottery_tickets_paid + lottery_winnings + question_bids_won
+// - question_bids_lost.
+type GameSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// ordered by rank (the bank is not included)
+	Players []*GameSummary_PlayerSummary `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// players with rank 1, more than one on a tie
+	WinnerUserIds []string `protobuf:"bytes,2,rep,name=winner_user_ids,json=winnerUserIds,proto3" json:"winner_user_ids,omitempty"`
 }
 
-func (x *JoinRequest) Reset() {
-	*x = JoinRequest{}
+func (x *GameSummary) Reset() {
+	*x = GameSummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -113,13 +223,13 @@ func (x *JoinRequest) Reset() {
 	}
 }
 
-func (x *JoinRequest) String() string {
+func (x *GameSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRequest) ProtoMessage() {}
+func (*GameSummary) ProtoMessage() {}
 
-func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+func (x *GameSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -131,44 +241,38 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
-func (*JoinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GameSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *JoinRequest) GetUsername() string {
+func (x *GameSummary) GetPlayers() []*GameSummary_PlayerSummary {
 	if x != nil {
-		return x.Username
+		return x.Players
 	}
-	return ""
+	return nil
 }
 
-type JoinResponse struct {
+func (x *GameSummary) GetWinnerUserIds() []string {
+	if x != nil {
+		return x.WinnerUserIds
+	}
+	return nil
+}
+
+type JoinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// players who already joined the game
-	Players []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
-	// fields related to the game configs
-	Duration              int32 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	PlayerPoints          int32 `protobuf:"varint,5,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"`
-	BankPointsPerPlayer   int32 `protobuf:"varint,6,opt,name=bank_points_per_player,json=bankPointsPerPlayer,proto3" json:"bank_points_per_player,omitempty"`
-	CreditInterest        int32 `protobuf:"varint,7,opt,name=credit_interest,json=creditInterest,proto3" json:"credit_interest,omitempty"`
-	DepositInterest       int32 `protobuf:"varint,8,opt,name=deposit_interest,json=depositInterest,proto3" json:"deposit_interest,omitempty"`
-	CreditTime            int32 `protobuf:"varint,9,opt,name=credit_time,json=creditTime,proto3" json:"credit_time,omitempty"`
-	DepositTime           int32 `protobuf:"varint,10,opt,name=deposit_time,json=depositTime,proto3" json:"deposit_time,omitempty"`
-	TheftTime             int32 `protobuf:"varint,11,opt,name=theft_time,json=theftTime,proto3" json:"theft_time,omitempty"`
-	TheftPercentage       int32 `protobuf:"varint,12,opt,name=theft_percentage,json=theftPercentage,proto3" json:"theft_percentage,omitempty"`
-	LotteryTime           int32 `protobuf:"varint,13,opt,name=lottery_time,json=lotteryTime,proto3" json:"lottery_time,omitempty"`
-	LotteryMaxWin         int32 `protobuf:"varint,14,opt,name=lottery_max_win,json=lotteryMaxWin,proto3" json:"lottery_max_win,omitempty"`
-	QuestionWinPercentage int32 `protobuf:"varint,15,opt,name=question_win_percentage,json=questionWinPercentage,proto3" json:"question_win_percentage,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// code of the lobby created with CreateLobby,
+	// empty to join the quick-match game
+	JoinCode string `protobuf:"bytes,2,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
 }
 
-func (x *JoinResponse) Reset() {
-	*x = JoinResponse{}
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -176,13 +280,13 @@ func (x *JoinResponse) Reset() {
 	}
 }
 
-func (x *JoinResponse) String() string {
+func (x *JoinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinResponse) ProtoMessage() {}
+func (*JoinRequest) ProtoMessage() {}
 
-func (x *JoinResponse) ProtoReflect() protoreflect.Message {
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -194,127 +298,39 @@ func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
-func (*JoinResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *JoinResponse) GetUserId() string {
+func (x *JoinRequest) GetUsername() string {
 	if x != nil {
-		return x.UserId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *JoinResponse) GetGameId() string {
+func (x *JoinRequest) GetJoinCode() string {
 	if x != nil {
-		return x.GameId
+		return x.JoinCode
 	}
 	return ""
 }
 
-func (x *JoinResponse) GetPlayers() []*Player {
-	if x != nil {
-		return x.Players
-	}
-	return nil
-}
-
-func (x *JoinResponse) GetDuration() int32 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetPlayerPoints() int32 {
-	if x != nil {
-		return x.PlayerPoints
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetBankPointsPerPlayer() int32 {
-	if x != nil {
-		return x.BankPointsPerPlayer
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetCreditInterest() int32 {
-	if x != nil {
-		return x.CreditInterest
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetDepositInterest() int32 {
-	if x != nil {
-		return x.DepositInterest
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetCreditTime() int32 {
-	if x != nil {
-		return x.CreditTime
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetDepositTime() int32 {
-	if x != nil {
-		return x.DepositTime
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetTheftTime() int32 {
-	if x != nil {
-		return x.TheftTime
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetTheftPercentage() int32 {
-	if x != nil {
-		return x.TheftPercentage
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetLotteryTime() int32 {
-	if x != nil {
-		return x.LotteryTime
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetLotteryMaxWin() int32 {
-	if x != nil {
-		return x.LotteryMaxWin
-	}
-	return 0
-}
-
-func (x *JoinResponse) GetQuestionWinPercentage() int32 {
-	if x != nil {
-		return x.QuestionWinPercentage
-	}
-	return 0
-}
-
-type LeaveRequest struct {
+type CreateLobbyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // creator joins the lobby
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// public lobbies are listed in ListLobbies,
+	// private ones can be joined only with the code
+	Public bool `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
 }
 
-func (x *LeaveRequest) Reset() {
-	*x = LeaveRequest{}
+func (x *CreateLobbyRequest) Reset() {
+	*x = CreateLobbyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -322,13 +338,13 @@ func (x *LeaveRequest) Reset() {
 	}
 }
 
-func (x *LeaveRequest) String() string {
+func (x *CreateLobbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveRequest) ProtoMessage() {}
+func (*CreateLobbyRequest) ProtoMessage() {}
 
-func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateLobbyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -340,33 +356,40 @@ func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
-func (*LeaveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateLobbyRequest.ProtoReflect.Descriptor instead.
+func (*CreateLobbyRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LeaveRequest) GetUserId() string {
+func (x *CreateLobbyRequest) GetUsername() string {
 	if x != nil {
-		return x.UserId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *LeaveRequest) GetGameId() string {
+func (x *CreateLobbyRequest) GetName() string {
 	if x != nil {
-		return x.GameId
+		return x.Name
 	}
 	return ""
 }
 
-type LeaveResponse struct {
+func (x *CreateLobbyRequest) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type ListLobbiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LeaveResponse) Reset() {
-	*x = LeaveResponse{}
+func (x *ListLobbiesRequest) Reset() {
+	*x = ListLobbiesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,13 +397,13 @@ func (x *LeaveResponse) Reset() {
 	}
 }
 
-func (x *LeaveResponse) String() string {
+func (x *ListLobbiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveResponse) ProtoMessage() {}
+func (*ListLobbiesRequest) ProtoMessage() {}
 
-func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
+func (x *ListLobbiesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -392,25 +415,21 @@ func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveResponse.ProtoReflect.Descriptor instead.
-func (*LeaveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLobbiesRequest.ProtoReflect.Descriptor instead.
+func (*ListLobbiesRequest) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{4}
 }
 
-// Ideally, we have also to check
-// user_id. But for now, let's simply
-// use game_id. Anyone, even people outside
-// the game can start it.
-type StartRequest struct {
+type ListLobbiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Lobbies []*ListLobbiesResponse_Lobby `protobuf:"bytes,1,rep,name=lobbies,proto3" json:"lobbies,omitempty"`
 }
 
-func (x *StartRequest) Reset() {
-	*x = StartRequest{}
+func (x *ListLobbiesResponse) Reset() {
+	*x = ListLobbiesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,13 +437,13 @@ func (x *StartRequest) Reset() {
 	}
 }
 
-func (x *StartRequest) String() string {
+func (x *ListLobbiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartRequest) ProtoMessage() {}
+func (*ListLobbiesResponse) ProtoMessage() {}
 
-func (x *StartRequest) ProtoReflect() protoreflect.Message {
+func (x *ListLobbiesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,26 +455,31 @@ func (x *StartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartRequest.ProtoReflect.Descriptor instead.
-func (*StartRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLobbiesResponse.ProtoReflect.Descriptor instead.
+func (*ListLobbiesResponse) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StartRequest) GetGameId() string {
+func (x *ListLobbiesResponse) GetLobbies() []*ListLobbiesResponse_Lobby {
 	if x != nil {
-		return x.GameId
+		return x.Lobbies
 	}
-	return ""
+	return nil
 }
 
-type StartResponse struct {
+// Percentage of bid points, which is won for the
+// correct answer to a question of given difficulty.
+type QuestionWinPercentage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Difficulty    QuestionDifficulty `protobuf:"varint,1,opt,name=difficulty,proto3,enum=server.QuestionDifficulty" json:"difficulty,omitempty"`
+	WinPercentage int32              `protobuf:"varint,2,opt,name=win_percentage,json=winPercentage,proto3" json:"win_percentage,omitempty"`
 }
 
-func (x *StartResponse) Reset() {
-	*x = StartResponse{}
+func (x *QuestionWinPercentage) Reset() {
+	*x = QuestionWinPercentage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,13 +487,13 @@ func (x *StartResponse) Reset() {
 	}
 }
 
-func (x *StartResponse) String() string {
+func (x *QuestionWinPercentage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartResponse) ProtoMessage() {}
+func (*QuestionWinPercentage) ProtoMessage() {}
 
-func (x *StartResponse) ProtoReflect() protoreflect.Message {
+func (x *QuestionWinPercentage) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -481,23 +505,82 @@ func (x *StartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartResponse.ProtoReflect.Descriptor instead.
-func (*StartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuestionWinPercentage.ProtoReflect.Descriptor instead.
+func (*QuestionWinPercentage) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{6}
 }
 
-type CreditRequest struct {
+func (x *QuestionWinPercentage) GetDifficulty() QuestionDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return QuestionDifficulty_EASY
+}
+
+func (x *QuestionWinPercentage) GetWinPercentage() int32 {
+	if x != nil {
+		return x.WinPercentage
+	}
+	return 0
+}
+
+type JoinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// players who already joined the game
+	Players []*Player `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	// fields related to the game configs
+	Duration            int32 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	PlayerPoints        int32 `protobuf:"varint,5,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"`
+	BankPointsPerPlayer int32 `protobuf:"varint,6,opt,name=bank_points_per_player,json=bankPointsPerPlayer,proto3" json:"bank_points_per_player,omitempty"`
+	CreditInterest      int32 `protobuf:"varint,7,opt,name=credit_interest,json=creditInterest,proto3" json:"credit_interest,omitempty"`
+	DepositInterest     int32 `protobuf:"varint,8,opt,name=deposit_interest,json=depositInterest,proto3" json:"deposit_interest,omitempty"`
+	CreditTime          int32 `protobuf:"varint,9,opt,name=credit_time,json=creditTime,proto3" json:"credit_time,omitempty"`
+	DepositTime         int32 `protobuf:"varint,10,opt,name=deposit_time,json=depositTime,proto3" json:"deposit_time,omitempty"`
+	TheftTime           int32 `protobuf:"varint,11,opt,name=theft_time,json=theftTime,proto3" json:"theft_time,omitempty"`
+	TheftPercentage     int32 `protobuf:"varint,12,opt,name=theft_percentage,json=theftPercentage,proto3" json:"theft_percentage,omitempty"`
+	LotteryTime         int32 `protobuf:"varint,13,opt,name=lottery_time,json=lotteryTime,proto3" json:"lottery_time,omitempty"`
+	LotteryMaxWin       int32 `protobuf:"varint,14,opt,name=lottery_max_win,json=lotteryMaxWin,proto3" json:"lottery_max_win,omitempty"`
+	// seconds given to answer the generated question
+	QuestionAnswerTime     int32                    `protobuf:"varint,16,opt,name=question_answer_time,json=questionAnswerTime,proto3" json:"question_answer_time,omitempty"`
+	QuestionWinPercentages []*QuestionWinPercentage `protobuf:"bytes,17,rep,name=question_win_percentages,json=questionWinPercentages,proto3" json:"question_win_percentages,omitempty"`
+	// categories, which can be requested in GenerateQuestionRequest
+	QuestionCategories []string `protobuf:"bytes,18,rep,name=question_categories,json=questionCategories,proto3" json:"question_categories,omitempty"`
+	// number of cells, which can be chosen in LotteryRequest
+	LotteryCellCount int32 `protobuf:"varint,19,opt,name=lottery_cell_count,json=lotteryCellCount,proto3" json:"lottery_cell_count,omitempty"`
+	// points, which can be won in the lottery cells before shuffling
+	// -1 stands for the jackpot cell, which pays the whole jackpot
+	LotteryCellValues []int32 `protobuf:"varint,20,rep,packed,name=lottery_cell_values,json=lotteryCellValues,proto3" json:"lottery_cell_values,omitempty"`
+	// price of a lottery ticket, 0 if the lottery is free
+	LotteryTicketPrice int32 `protobuf:"varint,21,opt,name=lottery_ticket_price,json=lotteryTicketPrice,proto3" json:"lottery_ticket_price,omitempty"`
+	// percentage of each ticket price, which goes to the jackpot
+	LotteryJackpotShare int32 `protobuf:"varint,22,opt,name=lottery_jackpot_share,json=lotteryJackpotShare,proto3" json:"lottery_jackpot_share,omitempty"`
+	// secret, which has to be passed to Resume to continue
+	// the game after the client has lost its state
+	ReconnectToken string `protobuf:"bytes,23,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"`
+	// code, which other players can use to join the lobby,
+	// empty for the quick-match game
+	JoinCode  string `protobuf:"bytes,24,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	LobbyName string `protobuf:"bytes,25,opt,name=lobby_name,json=lobbyName,proto3" json:"lobby_name,omitempty"`
+	// only the host can start the game, kick players and lock the lobby
+	HostUserId string `protobuf:"bytes,26,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	// auto-start rules, 0 if the rule is disabled:
+	// countdown of start_countdown seconds begins, when the game has
+	// min_players, and the game starts right away, when it has max_players
+	MinPlayers     int32 `protobuf:"varint,27,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	MaxPlayers     int32 `protobuf:"varint,28,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	StartCountdown int32 `protobuf:"varint,29,opt,name=start_countdown,json=startCountdown,proto3" json:"start_countdown,omitempty"`
+	// seconds until the auto-start, 0 if the countdown hasn't begun,
+	// so that the player joining during the countdown can show it
+	RemainingCountdown int32 `protobuf:"varint,30,opt,name=remaining_countdown,json=remainingCountdown,proto3" json:"remaining_countdown,omitempty"`
 }
 
-func (x *CreditRequest) Reset() {
-	*x = CreditRequest{}
+func (x *JoinResponse) Reset() {
+	*x = JoinResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_game_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,13 +588,13 @@ func (x *CreditRequest) Reset() {
 	}
 }
 
-func (x *CreditRequest) String() string {
+func (x *JoinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreditRequest) ProtoMessage() {}
+func (*JoinResponse) ProtoMessage() {}
 
-func (x *CreditRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_game_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,242 +606,263 @@ func (x *CreditRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreditRequest.ProtoReflect.Descriptor instead.
-func (*CreditRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
+func (*JoinResponse) Descriptor() ([]byte, []int) {
 	return file_game_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreditRequest) GetUserId() string {
+func (x *JoinResponse) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreditRequest) GetGameId() string {
+func (x *JoinResponse) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-func (x *CreditRequest) GetValue() int32 {
+func (x *JoinResponse) GetPlayers() []*Player {
 	if x != nil {
-		return x.Value
+		return x.Players
 	}
-	return 0
+	return nil
 }
 
-// In case of credit, it cannot be granted
-// if the bank doesn't have enough money or
-// if the user asks too much. These reasons
-// will be stated in "explanation" field if
-// "success" is false.
-type CreditResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+func (x *JoinResponse) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
 }
 
-func (x *CreditResponse) Reset() {
-	*x = CreditResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetPlayerPoints() int32 {
+	if x != nil {
+		return x.PlayerPoints
 	}
+	return 0
 }
 
-func (x *CreditResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinResponse) GetBankPointsPerPlayer() int32 {
+	if x != nil {
+		return x.BankPointsPerPlayer
+	}
+	return 0
 }
 
-func (*CreditResponse) ProtoMessage() {}
-
-func (x *CreditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetCreditInterest() int32 {
+	if x != nil {
+		return x.CreditInterest
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreditResponse.ProtoReflect.Descriptor instead.
-func (*CreditResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{8}
+func (x *JoinResponse) GetDepositInterest() int32 {
+	if x != nil {
+		return x.DepositInterest
+	}
+	return 0
 }
 
-func (x *CreditResponse) GetSuccess() bool {
+func (x *JoinResponse) GetCreditTime() int32 {
 	if x != nil {
-		return x.Success
+		return x.CreditTime
 	}
-	return false
+	return 0
 }
 
-func (x *CreditResponse) GetExplanation() string {
+func (x *JoinResponse) GetDepositTime() int32 {
 	if x != nil {
-		return x.Explanation
+		return x.DepositTime
 	}
-	return ""
+	return 0
 }
 
-type DepositRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *JoinResponse) GetTheftTime() int32 {
+	if x != nil {
+		return x.TheftTime
+	}
+	return 0
+}
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+func (x *JoinResponse) GetTheftPercentage() int32 {
+	if x != nil {
+		return x.TheftPercentage
+	}
+	return 0
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetLotteryTime() int32 {
+	if x != nil {
+		return x.LotteryTime
 	}
+	return 0
 }
 
-func (x *DepositRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinResponse) GetLotteryMaxWin() int32 {
+	if x != nil {
+		return x.LotteryMaxWin
+	}
+	return 0
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (x *JoinResponse) GetQuestionAnswerTime() int32 {
+	if x != nil {
+		return x.QuestionAnswerTime
+	}
+	return 0
+}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetQuestionWinPercentages() []*QuestionWinPercentage {
+	if x != nil {
+		return x.QuestionWinPercentages
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{9}
+func (x *JoinResponse) GetQuestionCategories() []string {
+	if x != nil {
+		return x.QuestionCategories
+	}
+	return nil
 }
 
-func (x *DepositRequest) GetUserId() string {
+func (x *JoinResponse) GetLotteryCellCount() int32 {
 	if x != nil {
-		return x.UserId
+		return x.LotteryCellCount
 	}
-	return ""
+	return 0
 }
 
-func (x *DepositRequest) GetGameId() string {
+func (x *JoinResponse) GetLotteryCellValues() []int32 {
 	if x != nil {
-		return x.GameId
+		return x.LotteryCellValues
 	}
-	return ""
+	return nil
 }
 
-func (x *DepositRequest) GetValue() int32 {
+func (x *JoinResponse) GetLotteryTicketPrice() int32 {
 	if x != nil {
-		return x.Value
+		return x.LotteryTicketPrice
 	}
 	return 0
 }
 
-// In case of deposit, it cannot be granted
-// if the user doesn't have enough money or
-// the user puts too much. These reasons
-// will be stated in "explanation" field if
-// "success" is false.
-type DepositResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *JoinResponse) GetLotteryJackpotShare() int32 {
+	if x != nil {
+		return x.LotteryJackpotShare
+	}
+	return 0
+}
 
-	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+func (x *JoinResponse) GetReconnectToken() string {
+	if x != nil {
+		return x.ReconnectToken
+	}
+	return ""
 }
 
-func (x *DepositResponse) Reset() {
-	*x = DepositResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinResponse) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
 	}
+	return ""
 }
 
-func (x *DepositResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinResponse) GetLobbyName() string {
+	if x != nil {
+		return x.LobbyName
+	}
+	return ""
 }
 
-func (*DepositResponse) ProtoMessage() {}
+func (x *JoinResponse) GetHostUserId() string {
+	if x != nil {
+		return x.HostUserId
+	}
+	return ""
+}
 
-func (x *DepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinResponse) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
-func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{10}
+func (x *JoinResponse) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
 }
 
-func (x *DepositResponse) GetSuccess() bool {
+func (x *JoinResponse) GetStartCountdown() int32 {
 	if x != nil {
-		return x.Success
+		return x.StartCountdown
 	}
-	return false
+	return 0
 }
 
-func (x *DepositResponse) GetExplanation() string {
+func (x *JoinResponse) GetRemainingCountdown() int32 {
 	if x != nil {
-		return x.Explanation
+		return x.RemainingCountdown
 	}
-	return ""
+	return 0
 }
 
-type LotteryRequest struct {
+// Game settings, which the host can choose for the lobby.
+// Fields have the same meaning as in JoinResponse.
+type GameConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	CellIndex int32  `protobuf:"varint,3,opt,name=cell_index,json=cellIndex,proto3" json:"cell_index,omitempty"` // has to be from 1 to 9
-}
-
-func (x *LotteryRequest) Reset() {
-	*x = LotteryRequest{}
+	Duration               int32                    `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	PlayerPoints           int32                    `protobuf:"varint,2,opt,name=player_points,json=playerPoints,proto3" json:"player_points,omitempty"`
+	BankPointsPerPlayer    int32                    `protobuf:"varint,3,opt,name=bank_points_per_player,json=bankPointsPerPlayer,proto3" json:"bank_points_per_player,omitempty"`
+	CreditInterest         int32                    `protobuf:"varint,4,opt,name=credit_interest,json=creditInterest,proto3" json:"credit_interest,omitempty"`
+	DepositInterest        int32                    `protobuf:"varint,5,opt,name=deposit_interest,json=depositInterest,proto3" json:"deposit_interest,omitempty"`
+	CreditTime             int32                    `protobuf:"varint,6,opt,name=credit_time,json=creditTime,proto3" json:"credit_time,omitempty"`
+	DepositTime            int32                    `protobuf:"varint,7,opt,name=deposit_time,json=depositTime,proto3" json:"deposit_time,omitempty"`
+	TheftTime              int32                    `protobuf:"varint,8,opt,name=theft_time,json=theftTime,proto3" json:"theft_time,omitempty"`
+	TheftPercentage        int32                    `protobuf:"varint,9,opt,name=theft_percentage,json=theftPercentage,proto3" json:"theft_percentage,omitempty"`
+	LotteryTime            int32                    `protobuf:"varint,10,opt,name=lottery_time,json=lotteryTime,proto3" json:"lottery_time,omitempty"`
+	LotteryMaxWin          int32                    `protobuf:"varint,11,opt,name=lottery_max_win,json=lotteryMaxWin,proto3" json:"lottery_max_win,omitempty"`
+	QuestionAnswerTime     int32                    `protobuf:"varint,12,opt,name=question_answer_time,json=questionAnswerTime,proto3" json:"question_answer_time,omitempty"`
+	QuestionWinPercentages []*QuestionWinPercentage `protobuf:"bytes,13,rep,name=question_win_percentages,json=questionWinPercentages,proto3" json:"question_win_percentages,omitempty"`
+	LotteryCellCount       int32                    `protobuf:"varint,14,opt,name=lottery_cell_count,json=lotteryCellCount,proto3" json:"lottery_cell_count,omitempty"`
+	// win for each lottery cell, -1 for the jackpot cell
+	LotteryPayouts []int32 `protobuf:"varint,15,rep,packed,name=lottery_payouts,json=lotteryPayouts,proto3" json:"lottery_payouts,omitempty"`
+	// lottery payouts are percentages of lottery_max_win instead of points
+	LotteryPayoutsInPercentage bool  `protobuf:"varint,16,opt,name=lottery_payouts_in_percentage,json=lotteryPayoutsInPercentage,proto3" json:"lottery_payouts_in_percentage,omitempty"`
+	LotteryTicketPrice         int32 `protobuf:"varint,17,opt,name=lottery_ticket_price,json=lotteryTicketPrice,proto3" json:"lottery_ticket_price,omitempty"`
+	LotteryJackpotShare        int32 `protobuf:"varint,18,opt,name=lottery_jackpot_share,json=lotteryJackpotShare,proto3" json:"lottery_jackpot_share,omitempty"`
+	MinPlayers                 int32 `protobuf:"varint,19,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"`
+	MaxPlayers                 int32 `protobuf:"varint,20,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"`
+	StartCountdown             int32 `protobuf:"varint,21,opt,name=start_countdown,json=startCountdown,proto3" json:"start_countdown,omitempty"`
+}
+
+func (x *GameConfig) Reset() {
+	*x = GameConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[11]
+		mi := &file_game_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LotteryRequest) String() string {
+func (x *GameConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LotteryRequest) ProtoMessage() {}
+func (*GameConfig) ProtoMessage() {}
 
-func (x *LotteryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[11]
+func (x *GameConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,187 +873,186 @@ func (x *LotteryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LotteryRequest.ProtoReflect.Descriptor instead.
-func (*LotteryRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GameConfig.ProtoReflect.Descriptor instead.
+func (*GameConfig) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LotteryRequest) GetUserId() string {
+func (x *GameConfig) GetDuration() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Duration
 	}
-	return ""
+	return 0
 }
 
-func (x *LotteryRequest) GetGameId() string {
+func (x *GameConfig) GetPlayerPoints() int32 {
 	if x != nil {
-		return x.GameId
+		return x.PlayerPoints
 	}
-	return ""
+	return 0
 }
 
-func (x *LotteryRequest) GetCellIndex() int32 {
+func (x *GameConfig) GetBankPointsPerPlayer() int32 {
 	if x != nil {
-		return x.CellIndex
+		return x.BankPointsPerPlayer
 	}
 	return 0
 }
 
-type LotteryResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GameConfig) GetCreditInterest() int32 {
+	if x != nil {
+		return x.CreditInterest
+	}
+	return 0
+}
 
-	Success    bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	CellValues []int32 `protobuf:"varint,2,rep,packed,name=cell_values,json=cellValues,proto3" json:"cell_values,omitempty"` // 9 values for each cell
-	WinPoints  int32   `protobuf:"varint,3,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+func (x *GameConfig) GetDepositInterest() int32 {
+	if x != nil {
+		return x.DepositInterest
+	}
+	return 0
 }
 
-func (x *LotteryResponse) Reset() {
-	*x = LotteryResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GameConfig) GetCreditTime() int32 {
+	if x != nil {
+		return x.CreditTime
 	}
+	return 0
 }
 
-func (x *LotteryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GameConfig) GetDepositTime() int32 {
+	if x != nil {
+		return x.DepositTime
+	}
+	return 0
 }
 
-func (*LotteryResponse) ProtoMessage() {}
-
-func (x *LotteryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GameConfig) GetTheftTime() int32 {
+	if x != nil {
+		return x.TheftTime
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use LotteryResponse.ProtoReflect.Descriptor instead.
-func (*LotteryResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{12}
+func (x *GameConfig) GetTheftPercentage() int32 {
+	if x != nil {
+		return x.TheftPercentage
+	}
+	return 0
 }
 
-func (x *LotteryResponse) GetSuccess() bool {
+func (x *GameConfig) GetLotteryTime() int32 {
 	if x != nil {
-		return x.Success
+		return x.LotteryTime
 	}
-	return false
+	return 0
 }
 
-func (x *LotteryResponse) GetCellValues() []int32 {
+func (x *GameConfig) GetLotteryMaxWin() int32 {
 	if x != nil {
-		return x.CellValues
+		return x.LotteryMaxWin
 	}
-	return nil
+	return 0
 }
 
-func (x *LotteryResponse) GetWinPoints() int32 {
+func (x *GameConfig) GetQuestionAnswerTime() int32 {
 	if x != nil {
-		return x.WinPoints
+		return x.QuestionAnswerTime
 	}
 	return 0
 }
 
-type GenerateQuestionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	// These points will be withdrawn during this request
-	// even if player does not answer the question
-	BidPoints int32 `protobuf:"varint,3,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
+func (x *GameConfig) GetQuestionWinPercentages() []*QuestionWinPercentage {
+	if x != nil {
+		return x.QuestionWinPercentages
+	}
+	return nil
 }
 
-func (x *GenerateQuestionRequest) Reset() {
-	*x = GenerateQuestionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GameConfig) GetLotteryCellCount() int32 {
+	if x != nil {
+		return x.LotteryCellCount
 	}
+	return 0
 }
 
-func (x *GenerateQuestionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GameConfig) GetLotteryPayouts() []int32 {
+	if x != nil {
+		return x.LotteryPayouts
+	}
+	return nil
 }
 
-func (*GenerateQuestionRequest) ProtoMessage() {}
+func (x *GameConfig) GetLotteryPayoutsInPercentage() bool {
+	if x != nil {
+		return x.LotteryPayoutsInPercentage
+	}
+	return false
+}
 
-func (x *GenerateQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GameConfig) GetLotteryTicketPrice() int32 {
+	if x != nil {
+		return x.LotteryTicketPrice
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GenerateQuestionRequest.ProtoReflect.Descriptor instead.
-func (*GenerateQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{13}
+func (x *GameConfig) GetLotteryJackpotShare() int32 {
+	if x != nil {
+		return x.LotteryJackpotShare
+	}
+	return 0
 }
 
-func (x *GenerateQuestionRequest) GetUserId() string {
+func (x *GameConfig) GetMinPlayers() int32 {
 	if x != nil {
-		return x.UserId
+		return x.MinPlayers
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateQuestionRequest) GetGameId() string {
+func (x *GameConfig) GetMaxPlayers() int32 {
 	if x != nil {
-		return x.GameId
+		return x.MaxPlayers
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateQuestionRequest) GetBidPoints() int32 {
+func (x *GameConfig) GetStartCountdown() int32 {
 	if x != nil {
-		return x.BidPoints
+		return x.StartCountdown
 	}
 	return 0
 }
 
-type GenerateQuestionResponse struct {
+// Only the host of the lobby can configure it before the start.
+type ConfigureLobbyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QuestionId string   `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Question   string   `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"` // 1 question
-	Answers    []string `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`   // 4 answers
+	UserId string      `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string      `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Config *GameConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *GenerateQuestionResponse) Reset() {
-	*x = GenerateQuestionResponse{}
+func (x *ConfigureLobbyRequest) Reset() {
+	*x = ConfigureLobbyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[14]
+		mi := &file_game_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateQuestionResponse) String() string {
+func (x *ConfigureLobbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateQuestionResponse) ProtoMessage() {}
+func (*ConfigureLobbyRequest) ProtoMessage() {}
 
-func (x *GenerateQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[14]
+func (x *ConfigureLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,60 +1063,55 @@ func (x *GenerateQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateQuestionResponse.ProtoReflect.Descriptor instead.
-func (*GenerateQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ConfigureLobbyRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GenerateQuestionResponse) GetQuestionId() string {
+func (x *ConfigureLobbyRequest) GetUserId() string {
 	if x != nil {
-		return x.QuestionId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GenerateQuestionResponse) GetQuestion() string {
+func (x *ConfigureLobbyRequest) GetGameId() string {
 	if x != nil {
-		return x.Question
+		return x.GameId
 	}
 	return ""
 }
 
-func (x *GenerateQuestionResponse) GetAnswers() []string {
+func (x *ConfigureLobbyRequest) GetConfig() *GameConfig {
 	if x != nil {
-		return x.Answers
+		return x.Config
 	}
 	return nil
 }
 
-type AnswerQuestionRequest struct {
+type ConfigureLobbyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GameId     string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	QuestionId string `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Answer     int32  `protobuf:"varint,4,opt,name=answer,proto3" json:"answer,omitempty"` // index from 1 to 4
 }
 
-func (x *AnswerQuestionRequest) Reset() {
-	*x = AnswerQuestionRequest{}
+func (x *ConfigureLobbyResponse) Reset() {
+	*x = ConfigureLobbyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[15]
+		mi := &file_game_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnswerQuestionRequest) String() string {
+func (x *ConfigureLobbyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnswerQuestionRequest) ProtoMessage() {}
+func (*ConfigureLobbyResponse) ProtoMessage() {}
 
-func (x *AnswerQuestionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[15]
+func (x *ConfigureLobbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,66 +1122,38 @@ func (x *AnswerQuestionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnswerQuestionRequest.ProtoReflect.Descriptor instead.
-func (*AnswerQuestionRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *AnswerQuestionRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *AnswerQuestionRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
-}
-
-func (x *AnswerQuestionRequest) GetQuestionId() string {
-	if x != nil {
-		return x.QuestionId
-	}
-	return ""
-}
-
-func (x *AnswerQuestionRequest) GetAnswer() int32 {
-	if x != nil {
-		return x.Answer
-	}
-	return 0
+// Deprecated: Use ConfigureLobbyResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureLobbyResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{10}
 }
 
-type AnswerQuestionResponse struct {
+// Player of the finished game joins its rematch lobby.
+type RematchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnswerIsCorrect bool  `protobuf:"varint,1,opt,name=answer_is_correct,json=answerIsCorrect,proto3" json:"answer_is_correct,omitempty"`
-	CorrectAnswer   int32 `protobuf:"varint,2,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"` // index from 1 to 4
-	WinPoints       int32 `protobuf:"varint,3,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`             // 0 if !answer_is_correct, otherwise (bid_points * question_win_percentage / 100)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // id of the finished game
 }
 
-func (x *AnswerQuestionResponse) Reset() {
-	*x = AnswerQuestionResponse{}
+func (x *RematchRequest) Reset() {
+	*x = RematchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[16]
+		mi := &file_game_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnswerQuestionResponse) String() string {
+func (x *RematchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnswerQuestionResponse) ProtoMessage() {}
+func (*RematchRequest) ProtoMessage() {}
 
-func (x *AnswerQuestionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[16]
+func (x *RematchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,33 +1164,26 @@ func (x *AnswerQuestionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnswerQuestionResponse.ProtoReflect.Descriptor instead.
-func (*AnswerQuestionResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *AnswerQuestionResponse) GetAnswerIsCorrect() bool {
-	if x != nil {
-		return x.AnswerIsCorrect
-	}
-	return false
+// Deprecated: Use RematchRequest.ProtoReflect.Descriptor instead.
+func (*RematchRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *AnswerQuestionResponse) GetCorrectAnswer() int32 {
+func (x *RematchRequest) GetUserId() string {
 	if x != nil {
-		return x.CorrectAnswer
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *AnswerQuestionResponse) GetWinPoints() int32 {
+func (x *RematchRequest) GetGameId() string {
 	if x != nil {
-		return x.WinPoints
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-type StreamRequest struct {
+type LeaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1129,23 +1192,23 @@ type StreamRequest struct {
 	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *StreamRequest) Reset() {
-	*x = StreamRequest{}
+func (x *LeaveRequest) Reset() {
+	*x = LeaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[17]
+		mi := &file_game_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamRequest) String() string {
+func (x *LeaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamRequest) ProtoMessage() {}
+func (*LeaveRequest) ProtoMessage() {}
 
-func (x *StreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[17]
+func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1156,56 +1219,48 @@ func (x *StreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
-func (*StreamRequest) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
+func (*LeaveRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StreamRequest) GetUserId() string {
+func (x *LeaveRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamRequest) GetGameId() string {
+func (x *LeaveRequest) GetGameId() string {
 	if x != nil {
 		return x.GameId
 	}
 	return ""
 }
 
-type StreamResponse struct {
+type LeaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Event:
-	//	*StreamResponse_Join_
-	//	*StreamResponse_Leave_
-	//	*StreamResponse_Start_
-	//	*StreamResponse_Finish_
-	//	*StreamResponse_Transaction_
-	Event isStreamResponse_Event `protobuf_oneof:"event"`
 }
 
-func (x *StreamResponse) Reset() {
-	*x = StreamResponse{}
+func (x *LeaveResponse) Reset() {
+	*x = LeaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[18]
+		mi := &file_game_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse) String() string {
+func (x *LeaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse) ProtoMessage() {}
+func (*LeaveResponse) ProtoMessage() {}
 
-func (x *StreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[18]
+func (x *LeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,114 +1271,98 @@ func (x *StreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse.ProtoReflect.Descriptor instead.
-func (*StreamResponse) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use LeaveResponse.ProtoReflect.Descriptor instead.
+func (*LeaveResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{13}
 }
 
-func (m *StreamResponse) GetEvent() isStreamResponse_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
-}
+// Only the host can kick other players before the start.
+type KickRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *StreamResponse) GetJoin() *StreamResponse_Join {
-	if x, ok := x.GetEvent().(*StreamResponse_Join_); ok {
-		return x.Join
-	}
-	return nil
+	UserId       string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId       string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	KickedUserId string `protobuf:"bytes,3,opt,name=kicked_user_id,json=kickedUserId,proto3" json:"kicked_user_id,omitempty"`
 }
 
-func (x *StreamResponse) GetLeave() *StreamResponse_Leave {
-	if x, ok := x.GetEvent().(*StreamResponse_Leave_); ok {
-		return x.Leave
+func (x *KickRequest) Reset() {
+	*x = KickRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *StreamResponse) GetStart() *StreamResponse_Start {
-	if x, ok := x.GetEvent().(*StreamResponse_Start_); ok {
-		return x.Start
-	}
-	return nil
+func (x *KickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StreamResponse) GetFinish() *StreamResponse_Finish {
-	if x, ok := x.GetEvent().(*StreamResponse_Finish_); ok {
-		return x.Finish
-	}
-	return nil
-}
+func (*KickRequest) ProtoMessage() {}
 
-func (x *StreamResponse) GetTransaction() *StreamResponse_Transaction {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_); ok {
-		return x.Transaction
+func (x *KickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
-}
-
-type isStreamResponse_Event interface {
-	isStreamResponse_Event()
-}
-
-type StreamResponse_Join_ struct {
-	// Events for game in "Waiting" state.
-	Join *StreamResponse_Join `protobuf:"bytes,1,opt,name=join,proto3,oneof"`
+	return mi.MessageOf(x)
 }
 
-type StreamResponse_Leave_ struct {
-	Leave *StreamResponse_Leave `protobuf:"bytes,2,opt,name=leave,proto3,oneof"`
+// Deprecated: Use KickRequest.ProtoReflect.Descriptor instead.
+func (*KickRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{14}
 }
 
-type StreamResponse_Start_ struct {
-	// Events for game in "Active" state.
-	Start *StreamResponse_Start `protobuf:"bytes,3,opt,name=start,proto3,oneof"`
+func (x *KickRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-type StreamResponse_Finish_ struct {
-	Finish *StreamResponse_Finish `protobuf:"bytes,4,opt,name=finish,proto3,oneof"`
+func (x *KickRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
 }
 
-type StreamResponse_Transaction_ struct {
-	Transaction *StreamResponse_Transaction `protobuf:"bytes,5,opt,name=transaction,proto3,oneof"`
+func (x *KickRequest) GetKickedUserId() string {
+	if x != nil {
+		return x.KickedUserId
+	}
+	return ""
 }
 
-func (*StreamResponse_Join_) isStreamResponse_Event() {}
-
-func (*StreamResponse_Leave_) isStreamResponse_Event() {}
-
-func (*StreamResponse_Start_) isStreamResponse_Event() {}
-
-func (*StreamResponse_Finish_) isStreamResponse_Event() {}
-
-func (*StreamResponse_Transaction_) isStreamResponse_Event() {}
-
-type StreamResponse_Join struct {
+type KickResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
 }
 
-func (x *StreamResponse_Join) Reset() {
-	*x = StreamResponse_Join{}
+func (x *KickResponse) Reset() {
+	*x = KickResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[19]
+		mi := &file_game_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Join) String() string {
+func (x *KickResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Join) ProtoMessage() {}
+func (*KickResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Join) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[19]
+func (x *KickResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1334,43 +1373,40 @@ func (x *StreamResponse_Join) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Join.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Join) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 0}
-}
-
-func (x *StreamResponse_Join) GetPlayer() *Player {
-	if x != nil {
-		return x.Player
-	}
-	return nil
+// Deprecated: Use KickResponse.ProtoReflect.Descriptor instead.
+func (*KickResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{15}
 }
 
-type StreamResponse_Leave struct {
+// Locked lobby can't be joined by new players.
+// Only the host can lock and unlock the lobby.
+type LockLobbyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Locked bool   `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
 }
 
-func (x *StreamResponse_Leave) Reset() {
-	*x = StreamResponse_Leave{}
+func (x *LockLobbyRequest) Reset() {
+	*x = LockLobbyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[20]
+		mi := &file_game_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Leave) String() string {
+func (x *LockLobbyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Leave) ProtoMessage() {}
+func (*LockLobbyRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Leave) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[20]
+func (x *LockLobbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,44 +1417,55 @@ func (x *StreamResponse_Leave) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Leave.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Leave) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 1}
+// Deprecated: Use LockLobbyRequest.ProtoReflect.Descriptor instead.
+func (*LockLobbyRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *StreamResponse_Leave) GetUserId() string {
+func (x *LockLobbyRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-// It might have contained time so that client
-// can precisely estimate the remaining time.
-// However, we will ignore it for now.
-type StreamResponse_Start struct {
+func (x *LockLobbyRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *LockLobbyRequest) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+type LockLobbyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StreamResponse_Start) Reset() {
-	*x = StreamResponse_Start{}
+func (x *LockLobbyResponse) Reset() {
+	*x = LockLobbyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[21]
+		mi := &file_game_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Start) String() string {
+func (x *LockLobbyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Start) ProtoMessage() {}
+func (*LockLobbyResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Start) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[21]
+func (x *LockLobbyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,37 +1476,38 @@ func (x *StreamResponse_Start) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Start.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Start) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 2}
+// Deprecated: Use LockLobbyResponse.ProtoReflect.Descriptor instead.
+func (*LockLobbyResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{17}
 }
 
-type StreamResponse_Finish struct {
+// Only the host can start the game.
+type StartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Players      []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	WinnerUserId string    `protobuf:"bytes,2,opt,name=winner_user_id,json=winnerUserId,proto3" json:"winner_user_id,omitempty"`
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *StreamResponse_Finish) Reset() {
-	*x = StreamResponse_Finish{}
+func (x *StartRequest) Reset() {
+	*x = StartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[22]
+		mi := &file_game_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Finish) String() string {
+func (x *StartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Finish) ProtoMessage() {}
+func (*StartRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Finish) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[22]
+func (x *StartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,65 +1518,48 @@ func (x *StreamResponse_Finish) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Finish.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Finish) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 3}
+// Deprecated: Use StartRequest.ProtoReflect.Descriptor instead.
+func (*StartRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StreamResponse_Finish) GetPlayers() []*Player {
+func (x *StartRequest) GetGameId() string {
 	if x != nil {
-		return x.Players
+		return x.GameId
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamResponse_Finish) GetWinnerUserId() string {
+func (x *StartRequest) GetUserId() string {
 	if x != nil {
-		return x.WinnerUserId
+		return x.UserId
 	}
 	return ""
 }
 
-type StreamResponse_Transaction struct {
+type StartResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Server will recalculate the amount
-	// of money for each player and send it
-	// to client. This way, client won't have
-	// to do calculations. It can just update
-	// the fields showing amounts of "points"
-	// for each player.
-	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	// Types that are assignable to Event:
-	//	*StreamResponse_Transaction_UseCredit_
-	//	*StreamResponse_Transaction_UseDeposit_
-	//	*StreamResponse_Transaction_ReturnCredit_
-	//	*StreamResponse_Transaction_ReturnDeposit_
-	//	*StreamResponse_Transaction_Theft_
-	//	*StreamResponse_Transaction_Lottery_
-	//	*StreamResponse_Transaction_Question_
-	Event isStreamResponse_Transaction_Event `protobuf_oneof:"event"`
 }
 
-func (x *StreamResponse_Transaction) Reset() {
-	*x = StreamResponse_Transaction{}
+func (x *StartResponse) Reset() {
+	*x = StartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[23]
+		mi := &file_game_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction) String() string {
+func (x *StartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction) ProtoMessage() {}
+func (*StartResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[23]
+func (x *StartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,146 +1570,90 @@ func (x *StreamResponse_Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4}
+// Deprecated: Use StartResponse.ProtoReflect.Descriptor instead.
+func (*StartResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *StreamResponse_Transaction) GetPlayers() []*Player {
-	if x != nil {
-		return x.Players
-	}
-	return nil
-}
+// Only the host can pause and resume the active game.
+type PauseGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *StreamResponse_Transaction) GetEvent() isStreamResponse_Transaction_Event {
-	if m != nil {
-		return m.Event
-	}
-	return nil
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *StreamResponse_Transaction) GetUseCredit() *StreamResponse_Transaction_UseCredit {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_UseCredit_); ok {
-		return x.UseCredit
+func (x *PauseGameRequest) Reset() {
+	*x = PauseGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *StreamResponse_Transaction) GetUseDeposit() *StreamResponse_Transaction_UseDeposit {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_UseDeposit_); ok {
-		return x.UseDeposit
-	}
-	return nil
+func (x *PauseGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StreamResponse_Transaction) GetReturnCredit() *StreamResponse_Transaction_ReturnCredit {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_ReturnCredit_); ok {
-		return x.ReturnCredit
-	}
-	return nil
-}
+func (*PauseGameRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction) GetReturnDeposit() *StreamResponse_Transaction_ReturnDeposit {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_ReturnDeposit_); ok {
-		return x.ReturnDeposit
+func (x *PauseGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StreamResponse_Transaction) GetTheft() *StreamResponse_Transaction_Theft {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Theft_); ok {
-		return x.Theft
-	}
-	return nil
+// Deprecated: Use PauseGameRequest.ProtoReflect.Descriptor instead.
+func (*PauseGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *StreamResponse_Transaction) GetLottery() *StreamResponse_Transaction_Lottery {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Lottery_); ok {
-		return x.Lottery
+func (x *PauseGameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamResponse_Transaction) GetQuestion() *StreamResponse_Transaction_Question {
-	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Question_); ok {
-		return x.Question
+func (x *PauseGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
 	}
-	return nil
-}
-
-type isStreamResponse_Transaction_Event interface {
-	isStreamResponse_Transaction_Event()
-}
-
-type StreamResponse_Transaction_UseCredit_ struct {
-	UseCredit *StreamResponse_Transaction_UseCredit `protobuf:"bytes,2,opt,name=use_credit,json=useCredit,proto3,oneof"`
-}
-
-type StreamResponse_Transaction_UseDeposit_ struct {
-	UseDeposit *StreamResponse_Transaction_UseDeposit `protobuf:"bytes,3,opt,name=use_deposit,json=useDeposit,proto3,oneof"`
-}
-
-type StreamResponse_Transaction_ReturnCredit_ struct {
-	ReturnCredit *StreamResponse_Transaction_ReturnCredit `protobuf:"bytes,4,opt,name=return_credit,json=returnCredit,proto3,oneof"`
-}
-
-type StreamResponse_Transaction_ReturnDeposit_ struct {
-	ReturnDeposit *StreamResponse_Transaction_ReturnDeposit `protobuf:"bytes,5,opt,name=return_deposit,json=returnDeposit,proto3,oneof"`
-}
-
-type StreamResponse_Transaction_Theft_ struct {
-	Theft *StreamResponse_Transaction_Theft `protobuf:"bytes,6,opt,name=theft,proto3,oneof"`
-}
-
-type StreamResponse_Transaction_Lottery_ struct {
-	Lottery *StreamResponse_Transaction_Lottery `protobuf:"bytes,7,opt,name=lottery,proto3,oneof"`
-}
-
-type StreamResponse_Transaction_Question_ struct {
-	Question *StreamResponse_Transaction_Question `protobuf:"bytes,8,opt,name=question,proto3,oneof"`
+	return ""
 }
 
-func (*StreamResponse_Transaction_UseCredit_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_UseDeposit_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_ReturnCredit_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_ReturnDeposit_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_Theft_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_Lottery_) isStreamResponse_Transaction_Event() {}
-
-func (*StreamResponse_Transaction_Question_) isStreamResponse_Transaction_Event() {}
-
-type StreamResponse_Transaction_UseCredit struct {
+type PauseGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_UseCredit) Reset() {
-	*x = StreamResponse_Transaction_UseCredit{}
+func (x *PauseGameResponse) Reset() {
+	*x = PauseGameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[24]
+		mi := &file_game_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_UseCredit) String() string {
+func (x *PauseGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_UseCredit) ProtoMessage() {}
+func (*PauseGameResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_UseCredit) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[24]
+func (x *PauseGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,51 +1664,37 @@ func (x *StreamResponse_Transaction_UseCredit) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_UseCredit.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_UseCredit) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 0}
-}
-
-func (x *StreamResponse_Transaction_UseCredit) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *StreamResponse_Transaction_UseCredit) GetValue() int32 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
+// Deprecated: Use PauseGameResponse.ProtoReflect.Descriptor instead.
+func (*PauseGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{21}
 }
 
-type StreamResponse_Transaction_UseDeposit struct {
+type ResumeGameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_UseDeposit) Reset() {
-	*x = StreamResponse_Transaction_UseDeposit{}
+func (x *ResumeGameRequest) Reset() {
+	*x = ResumeGameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[25]
+		mi := &file_game_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_UseDeposit) String() string {
+func (x *ResumeGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_UseDeposit) ProtoMessage() {}
+func (*ResumeGameRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_UseDeposit) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[25]
+func (x *ResumeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,51 +1705,48 @@ func (x *StreamResponse_Transaction_UseDeposit) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_UseDeposit.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_UseDeposit) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 1}
+// Deprecated: Use ResumeGameRequest.ProtoReflect.Descriptor instead.
+func (*ResumeGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *StreamResponse_Transaction_UseDeposit) GetUserId() string {
+func (x *ResumeGameRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_UseDeposit) GetValue() int32 {
+func (x *ResumeGameRequest) GetGameId() string {
 	if x != nil {
-		return x.Value
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-type StreamResponse_Transaction_ReturnCredit struct {
+type ResumeGameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_ReturnCredit) Reset() {
-	*x = StreamResponse_Transaction_ReturnCredit{}
+func (x *ResumeGameResponse) Reset() {
+	*x = ResumeGameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[26]
+		mi := &file_game_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_ReturnCredit) String() string {
+func (x *ResumeGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_ReturnCredit) ProtoMessage() {}
+func (*ResumeGameResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_ReturnCredit) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[26]
+func (x *ResumeGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,51 +1757,38 @@ func (x *StreamResponse_Transaction_ReturnCredit) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_ReturnCredit.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_ReturnCredit) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 2}
-}
-
-func (x *StreamResponse_Transaction_ReturnCredit) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *StreamResponse_Transaction_ReturnCredit) GetValue() int32 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
+// Deprecated: Use ResumeGameResponse.ProtoReflect.Descriptor instead.
+func (*ResumeGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{23}
 }
 
-type StreamResponse_Transaction_ReturnDeposit struct {
+type CreditRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_ReturnDeposit) Reset() {
-	*x = StreamResponse_Transaction_ReturnDeposit{}
+func (x *CreditRequest) Reset() {
+	*x = CreditRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[27]
+		mi := &file_game_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_ReturnDeposit) String() string {
+func (x *CreditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_ReturnDeposit) ProtoMessage() {}
+func (*CreditRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_ReturnDeposit) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[27]
+func (x *CreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1854,50 +1799,63 @@ func (x *StreamResponse_Transaction_ReturnDeposit) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_ReturnDeposit.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_ReturnDeposit) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 3}
+// Deprecated: Use CreditRequest.ProtoReflect.Descriptor instead.
+func (*CreditRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *StreamResponse_Transaction_ReturnDeposit) GetUserId() string {
+func (x *CreditRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_ReturnDeposit) GetValue() int32 {
+func (x *CreditRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *CreditRequest) GetValue() int32 {
 	if x != nil {
 		return x.Value
 	}
 	return 0
 }
 
-type StreamResponse_Transaction_Theft struct {
+// In case of credit, it cannot be granted
+// if the bank doesn't have enough money or
+// if the user asks too much. These reasons
+// will be stated in "explanation" field if
+// "success" is false.
+type CreditResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RobbedPlayers []*StreamResponse_Transaction_Theft_RobbedPlayer `protobuf:"bytes,1,rep,name=robbed_players,json=robbedPlayers,proto3" json:"robbed_players,omitempty"`
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_Theft) Reset() {
-	*x = StreamResponse_Transaction_Theft{}
+func (x *CreditResponse) Reset() {
+	*x = CreditResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[28]
+		mi := &file_game_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_Theft) String() string {
+func (x *CreditResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_Theft) ProtoMessage() {}
+func (*CreditResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_Theft) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[28]
+func (x *CreditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,44 +1866,52 @@ func (x *StreamResponse_Transaction_Theft) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_Theft.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_Theft) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 4}
+// Deprecated: Use CreditResponse.ProtoReflect.Descriptor instead.
+func (*CreditResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *StreamResponse_Transaction_Theft) GetRobbedPlayers() []*StreamResponse_Transaction_Theft_RobbedPlayer {
+func (x *CreditResponse) GetSuccess() bool {
 	if x != nil {
-		return x.RobbedPlayers
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type StreamResponse_Transaction_Lottery struct {
+func (x *CreditResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type DepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_Lottery) Reset() {
-	*x = StreamResponse_Transaction_Lottery{}
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[29]
+		mi := &file_game_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_Lottery) String() string {
+func (x *DepositRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_Lottery) ProtoMessage() {}
+func (*DepositRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_Lottery) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[29]
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,53 +1922,63 @@ func (x *StreamResponse_Transaction_Lottery) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_Lottery.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_Lottery) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 5}
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *StreamResponse_Transaction_Lottery) GetUserId() string {
+func (x *DepositRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_Lottery) GetValue() int32 {
+func (x *DepositRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetValue() int32 {
 	if x != nil {
 		return x.Value
 	}
 	return 0
 }
 
-type StreamResponse_Transaction_Question struct {
+// In case of deposit, it cannot be granted
+// if the user doesn't have enough money or
+// the user puts too much. These reasons
+// will be stated in "explanation" field if
+// "success" is false.
+type DepositResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId          string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AnswerIsCorrect bool   `protobuf:"varint,2,opt,name=answer_is_correct,json=answerIsCorrect,proto3" json:"answer_is_correct,omitempty"`
-	BidPoints       int32  `protobuf:"varint,3,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
-	WinPoints       int32  `protobuf:"varint,4,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+	Success     bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Explanation string `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_Question) Reset() {
-	*x = StreamResponse_Transaction_Question{}
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[30]
+		mi := &file_game_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_Question) String() string {
+func (x *DepositResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_Question) ProtoMessage() {}
+func (*DepositResponse) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_Question) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[30]
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,66 +1989,196 @@ func (x *StreamResponse_Transaction_Question) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_Question.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_Question) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 6}
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *StreamResponse_Transaction_Question) GetUserId() string {
+func (x *DepositResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DepositResponse) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type LotteryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId    string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	CellIndex int32  `protobuf:"varint,3,opt,name=cell_index,json=cellIndex,proto3" json:"cell_index,omitempty"` // has to be from 1 to lottery_cell_count
+}
+
+func (x *LotteryRequest) Reset() {
+	*x = LotteryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LotteryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotteryRequest) ProtoMessage() {}
+
+func (x *LotteryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotteryRequest.ProtoReflect.Descriptor instead.
+func (*LotteryRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LotteryRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_Question) GetAnswerIsCorrect() bool {
+func (x *LotteryRequest) GetGameId() string {
 	if x != nil {
-		return x.AnswerIsCorrect
+		return x.GameId
 	}
-	return false
+	return ""
 }
 
-func (x *StreamResponse_Transaction_Question) GetBidPoints() int32 {
+func (x *LotteryRequest) GetCellIndex() int32 {
 	if x != nil {
-		return x.BidPoints
+		return x.CellIndex
 	}
 	return 0
 }
 
-func (x *StreamResponse_Transaction_Question) GetWinPoints() int32 {
+type LotteryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	CellValues []int32 `protobuf:"varint,2,rep,packed,name=cell_values,json=cellValues,proto3" json:"cell_values,omitempty"` // value for each cell
+	WinPoints  int32   `protobuf:"varint,3,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+	// salt and commitment are set only if the board has been
+	// committed with LotteryCommitment before the lottery.
+	// sha256(salt + cell_values) has to be equal to commitment.
+	Salt       []byte `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	Commitment string `protobuf:"bytes,5,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *LotteryResponse) Reset() {
+	*x = LotteryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LotteryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotteryResponse) ProtoMessage() {}
+
+func (x *LotteryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotteryResponse.ProtoReflect.Descriptor instead.
+func (*LotteryResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LotteryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LotteryResponse) GetCellValues() []int32 {
+	if x != nil {
+		return x.CellValues
+	}
+	return nil
+}
+
+func (x *LotteryResponse) GetWinPoints() int32 {
 	if x != nil {
 		return x.WinPoints
 	}
 	return 0
 }
 
-type StreamResponse_Transaction_Theft_RobbedPlayer struct {
+func (x *LotteryResponse) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *LotteryResponse) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+type LotteryCommitmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// value shows how much money has been stolen from the player.
-	Value int32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 }
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) Reset() {
-	*x = StreamResponse_Transaction_Theft_RobbedPlayer{}
+func (x *LotteryCommitmentRequest) Reset() {
+	*x = LotteryCommitmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_game_proto_msgTypes[31]
+		mi := &file_game_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) String() string {
+func (x *LotteryCommitmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamResponse_Transaction_Theft_RobbedPlayer) ProtoMessage() {}
+func (*LotteryCommitmentRequest) ProtoMessage() {}
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) ProtoReflect() protoreflect.Message {
-	mi := &file_game_proto_msgTypes[31]
+func (x *LotteryCommitmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2083,399 +2189,3942 @@ func (x *StreamResponse_Transaction_Theft_RobbedPlayer) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamResponse_Transaction_Theft_RobbedPlayer.ProtoReflect.Descriptor instead.
-func (*StreamResponse_Transaction_Theft_RobbedPlayer) Descriptor() ([]byte, []int) {
-	return file_game_proto_rawDescGZIP(), []int{18, 4, 4, 0}
+// Deprecated: Use LotteryCommitmentRequest.ProtoReflect.Descriptor instead.
+func (*LotteryCommitmentRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetUserId() string {
+func (x *LotteryCommitmentRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetValue() int32 {
+func (x *LotteryCommitmentRequest) GetGameId() string {
 	if x != nil {
-		return x.Value
+		return x.GameId
 	}
-	return 0
+	return ""
 }
 
-var File_game_proto protoreflect.FileDescriptor
+type LotteryCommitmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_game_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x0b, 0x4a,
-	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc5, 0x04, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x62, 0x61, 0x6e, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x50, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
-	0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x65, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x29, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x68, 0x65, 0x66,
-	0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
-	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26,
-	0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69,
-	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
-	0x4d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
-	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x57, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x40,
-	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
-	0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x27, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x0f,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70,
-	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x0e, 0x4c,
-	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x6b,
-	0x0a, 0x0f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x0a, 0x63, 0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x77, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x17, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69,
-	0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x71, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22,
-	0x8a, 0x01, 0x0a, 0x16, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x73, 0x43,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
-	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x41, 0x0a, 0x0d,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22,
-	0xc3, 0x0d, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x48, 0x00, 0x52,
-	0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x2e, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x1a, 0x20, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x1a, 0x07, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x1a, 0x58, 0x0a,
-	0x06, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x1a, 0xd2, 0x09, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x12, 0x4d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x09, 0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x12, 0x50, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x12, 0x56, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x40, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x66, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x68, 0x65, 0x66, 0x74, 0x48, 0x00,
-	0x52, 0x05, 0x74, 0x68, 0x65, 0x66, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x6c, 0x6f, 0x74, 0x74, 0x65,
-	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x74,
-	0x74, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12,
-	0x49, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3a, 0x0a, 0x09, 0x55, 0x73,
-	0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x3b, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x1a, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x1a, 0xa4, 0x01, 0x0a, 0x05, 0x54, 0x68, 0x65, 0x66, 0x74, 0x12, 0x5c, 0x0a, 0x0e,
-	0x72, 0x6f, 0x62, 0x62, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x68, 0x65, 0x66, 0x74, 0x2e, 0x52,
-	0x6f, 0x62, 0x62, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x0d, 0x72, 0x6f, 0x62,
-	0x62, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0c, 0x52, 0x6f,
-	0x62, 0x62, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x38, 0x0a, 0x07, 0x4c, 0x6f, 0x74,
-	0x74, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x8d, 0x01, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x73, 0x43, 0x6f,
-	0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69, 0x64, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x32, 0xcb, 0x04, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x33,
-	0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x14, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x15, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c,
-	0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x07,
-	0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	// hex-encoded sha256 of salt and the shuffled board,
+	// which will be used in the next lottery of the player
+	Commitment string `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-var (
-	file_game_proto_rawDescOnce sync.Once
-	file_game_proto_rawDescData = file_game_proto_rawDesc
-)
-
-func file_game_proto_rawDescGZIP() []byte {
-	file_game_proto_rawDescOnce.Do(func() {
-		file_game_proto_rawDescData = protoimpl.X.CompressGZIP(file_game_proto_rawDescData)
-	})
-	return file_game_proto_rawDescData
+func (x *LotteryCommitmentResponse) Reset() {
+	*x = LotteryCommitmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
-var file_game_proto_goTypes = []interface{}{
-	(*Player)(nil),                                        // 0: server.Player
-	(*JoinRequest)(nil),                                   // 1: server.JoinRequest
-	(*JoinResponse)(nil),                                  // 2: server.JoinResponse
-	(*LeaveRequest)(nil),                                  // 3: server.LeaveRequest
-	(*LeaveResponse)(nil),                                 // 4: server.LeaveResponse
-	(*StartRequest)(nil),                                  // 5: server.StartRequest
-	(*StartResponse)(nil),                                 // 6: server.StartResponse
-	(*CreditRequest)(nil),                                 // 7: server.CreditRequest
-	(*CreditResponse)(nil),                                // 8: server.CreditResponse
-	(*DepositRequest)(nil),                                // 9: server.DepositRequest
-	(*DepositResponse)(nil),                               // 10: server.DepositResponse
-	(*LotteryRequest)(nil),                                // 11: server.LotteryRequest
-	(*LotteryResponse)(nil),                               // 12: server.LotteryResponse
-	(*GenerateQuestionRequest)(nil),                       // 13: server.GenerateQuestionRequest
-	(*GenerateQuestionResponse)(nil),                      // 14: server.GenerateQuestionResponse
-	(*AnswerQuestionRequest)(nil),                         // 15: server.AnswerQuestionRequest
-	(*AnswerQuestionResponse)(nil),                        // 16: server.AnswerQuestionResponse
-	(*StreamRequest)(nil),                                 // 17: server.StreamRequest
-	(*StreamResponse)(nil),                                // 18: server.StreamResponse
-	(*StreamResponse_Join)(nil),                           // 19: server.StreamResponse.Join
-	(*StreamResponse_Leave)(nil),                          // 20: server.StreamResponse.Leave
-	(*StreamResponse_Start)(nil),                          // 21: server.StreamResponse.Start
-	(*StreamResponse_Finish)(nil),                         // 22: server.StreamResponse.Finish
-	(*StreamResponse_Transaction)(nil),                    // 23: server.StreamResponse.Transaction
-	(*StreamResponse_Transaction_UseCredit)(nil),          // 24: server.StreamResponse.Transaction.UseCredit
-	(*StreamResponse_Transaction_UseDeposit)(nil),         // 25: server.StreamResponse.Transaction.UseDeposit
-	(*StreamResponse_Transaction_ReturnCredit)(nil),       // 26: server.StreamResponse.Transaction.ReturnCredit
-	(*StreamResponse_Transaction_ReturnDeposit)(nil),      // 27: server.StreamResponse.Transaction.ReturnDeposit
-	(*StreamResponse_Transaction_Theft)(nil),              // 28: server.StreamResponse.Transaction.Theft
-	(*StreamResponse_Transaction_Lottery)(nil),            // 29: server.StreamResponse.Transaction.Lottery
-	(*StreamResponse_Transaction_Question)(nil),           // 30: server.StreamResponse.Transaction.Question
-	(*StreamResponse_Transaction_Theft_RobbedPlayer)(nil), // 31: server.StreamResponse.Transaction.Theft.RobbedPlayer
+func (x *LotteryCommitmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_game_proto_depIdxs = []int32{
-	0,  // 0: server.JoinResponse.players:type_name -> server.Player
-	19, // 1: server.StreamResponse.join:type_name -> server.StreamResponse.Join
-	20, // 2: server.StreamResponse.leave:type_name -> server.StreamResponse.Leave
-	21, // 3: server.StreamResponse.start:type_name -> server.StreamResponse.Start
-	22, // 4: server.StreamResponse.finish:type_name -> server.StreamResponse.Finish
-	23, // 5: server.StreamResponse.transaction:type_name -> server.StreamResponse.Transaction
-	0,  // 6: server.StreamResponse.Join.player:type_name -> server.Player
-	0,  // 7: server.StreamResponse.Finish.players:type_name -> server.Player
-	0,  // 8: server.StreamResponse.Transaction.players:type_name -> server.Player
-	24, // 9: server.StreamResponse.Transaction.use_credit:type_name -> server.StreamResponse.Transaction.UseCredit
-	25, // 10: server.StreamResponse.Transaction.use_deposit:type_name -> server.StreamResponse.Transaction.UseDeposit
-	26, // 11: server.StreamResponse.Transaction.return_credit:type_name -> server.StreamResponse.Transaction.ReturnCredit
-	27, // 12: server.StreamResponse.Transaction.return_deposit:type_name -> server.StreamResponse.Transaction.ReturnDeposit
-	28, // 13: server.StreamResponse.Transaction.theft:type_name -> server.StreamResponse.Transaction.Theft
-	29, // 14: server.StreamResponse.Transaction.lottery:type_name -> server.StreamResponse.Transaction.Lottery
-	30, // 15: server.StreamResponse.Transaction.question:type_name -> server.StreamResponse.Transaction.Question
-	31, // 16: server.StreamResponse.Transaction.Theft.robbed_players:type_name -> server.StreamResponse.Transaction.Theft.RobbedPlayer
-	1,  // 17: server.Game.Join:input_type -> server.JoinRequest
-	3,  // 18: server.Game.Leave:input_type -> server.LeaveRequest
-	5,  // 19: server.Game.Start:input_type -> server.StartRequest
-	7,  // 20: server.Game.Credit:input_type -> server.CreditRequest
-	9,  // 21: server.Game.Deposit:input_type -> server.DepositRequest
-	11, // 22: server.Game.Lottery:input_type -> server.LotteryRequest
-	13, // 23: server.Game.GenerateQuestion:input_type -> server.GenerateQuestionRequest
-	15, // 24: server.Game.AnswerQuestion:input_type -> server.AnswerQuestionRequest
-	17, // 25: server.Game.Stream:input_type -> server.StreamRequest
-	2,  // 26: server.Game.Join:output_type -> server.JoinResponse
-	4,  // 27: server.Game.Leave:output_type -> server.LeaveResponse
-	6,  // 28: server.Game.Start:output_type -> server.StartResponse
-	8,  // 29: server.Game.Credit:output_type -> server.CreditResponse
-	10, // 30: server.Game.Deposit:output_type -> server.DepositResponse
-	12, // 31: server.Game.Lottery:output_type -> server.LotteryResponse
-	14, // 32: server.Game.GenerateQuestion:output_type -> server.GenerateQuestionResponse
-	16, // 33: server.Game.AnswerQuestion:output_type -> server.AnswerQuestionResponse
-	18, // 34: server.Game.Stream:output_type -> server.StreamResponse
-	26, // [26:35] is the sub-list for method output_type
-	17, // [17:26] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+
+func (*LotteryCommitmentResponse) ProtoMessage() {}
+
+func (x *LotteryCommitmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_game_proto_init() }
-func file_game_proto_init() {
-	if File_game_proto != nil {
-		return
+// Deprecated: Use LotteryCommitmentResponse.ProtoReflect.Descriptor instead.
+func (*LotteryCommitmentResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LotteryCommitmentResponse) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_game_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Player); i {
+	return ""
+}
+
+type GenerateQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// These points will be withdrawn during this request
+	// even if player does not answer the question
+	BidPoints  int32              `protobuf:"varint,3,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
+	Difficulty QuestionDifficulty `protobuf:"varint,4,opt,name=difficulty,proto3,enum=server.QuestionDifficulty" json:"difficulty,omitempty"`
+	// one of question_categories from JoinResponse,
+	// empty for question of any category
+	Category string       `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Type     QuestionType `protobuf:"varint,6,opt,name=type,proto3,enum=server.QuestionType" json:"type,omitempty"`
+}
+
+func (x *GenerateQuestionRequest) Reset() {
+	*x = GenerateQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuestionRequest) ProtoMessage() {}
+
+func (x *GenerateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GenerateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GenerateQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateQuestionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GenerateQuestionRequest) GetBidPoints() int32 {
+	if x != nil {
+		return x.BidPoints
+	}
+	return 0
+}
+
+func (x *GenerateQuestionRequest) GetDifficulty() QuestionDifficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return QuestionDifficulty_EASY
+}
+
+func (x *GenerateQuestionRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GenerateQuestionRequest) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_MULTIPLE
+}
+
+type GenerateQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string       `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Question   string       `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"` // 1 question
+	Answers    []string     `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`   // 4 answers for MULTIPLE, 2 for BOOLEAN, none for NUMERIC
+	Type       QuestionType `protobuf:"varint,4,opt,name=type,proto3,enum=server.QuestionType" json:"type,omitempty"`
+	// answer has to be from answer_min to answer_max (inclusive), i.e.
+	// index of answer for MULTIPLE and BOOLEAN, estimate for NUMERIC
+	AnswerMin int32 `protobuf:"varint,5,opt,name=answer_min,json=answerMin,proto3" json:"answer_min,omitempty"`
+	AnswerMax int32 `protobuf:"varint,6,opt,name=answer_max,json=answerMax,proto3" json:"answer_max,omitempty"`
+}
+
+func (x *GenerateQuestionResponse) Reset() {
+	*x = GenerateQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateQuestionResponse) ProtoMessage() {}
+
+func (x *GenerateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GenerateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GenerateQuestionResponse) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *GenerateQuestionResponse) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *GenerateQuestionResponse) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *GenerateQuestionResponse) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_MULTIPLE
+}
+
+func (x *GenerateQuestionResponse) GetAnswerMin() int32 {
+	if x != nil {
+		return x.AnswerMin
+	}
+	return 0
+}
+
+func (x *GenerateQuestionResponse) GetAnswerMax() int32 {
+	if x != nil {
+		return x.AnswerMax
+	}
+	return 0
+}
+
+type AnswerQuestionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId     string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	QuestionId string `protobuf:"bytes,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Answer     int32  `protobuf:"varint,4,opt,name=answer,proto3" json:"answer,omitempty"` // from answer_min to answer_max of GenerateQuestionResponse
+}
+
+func (x *AnswerQuestionRequest) Reset() {
+	*x = AnswerQuestionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerQuestionRequest) ProtoMessage() {}
+
+func (x *AnswerQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerQuestionRequest.ProtoReflect.Descriptor instead.
+func (*AnswerQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AnswerQuestionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AnswerQuestionRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AnswerQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerQuestionRequest) GetAnswer() int32 {
+	if x != nil {
+		return x.Answer
+	}
+	return 0
+}
+
+type AnswerQuestionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// for NUMERIC, true if the estimate is close enough to win some points
+	AnswerIsCorrect bool  `protobuf:"varint,1,opt,name=answer_is_correct,json=answerIsCorrect,proto3" json:"answer_is_correct,omitempty"`
+	CorrectAnswer   int32 `protobuf:"varint,2,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"` // index of correct answer, or exact number for NUMERIC
+	// 0 if !answer_is_correct, otherwise (bid_points * win_percentage / 100) for question difficulty.
+	// For NUMERIC, it is scaled by how close the estimate is to the correct answer.
+	WinPoints int32 `protobuf:"varint,3,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+	// true if the answer came later than question_answer_time seconds
+	// after the question was generated. Bid points are forfeited then.
+	Expired bool `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *AnswerQuestionResponse) Reset() {
+	*x = AnswerQuestionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerQuestionResponse) ProtoMessage() {}
+
+func (x *AnswerQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerQuestionResponse.ProtoReflect.Descriptor instead.
+func (*AnswerQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AnswerQuestionResponse) GetAnswerIsCorrect() bool {
+	if x != nil {
+		return x.AnswerIsCorrect
+	}
+	return false
+}
+
+func (x *AnswerQuestionResponse) GetCorrectAnswer() int32 {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return 0
+}
+
+func (x *AnswerQuestionResponse) GetWinPoints() int32 {
+	if x != nil {
+		return x.WinPoints
+	}
+	return 0
+}
+
+func (x *AnswerQuestionResponse) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type StreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Sequence number of the last event, which the player has received.
+	// All events after it are sent before new events.
+	// Use 0 to receive all events of the game.
+	LastSeenSequence int64 `protobuf:"varint,3,opt,name=last_seen_sequence,json=lastSeenSequence,proto3" json:"last_seen_sequence,omitempty"`
+}
+
+func (x *StreamRequest) Reset() {
+	*x = StreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRequest) ProtoMessage() {}
+
+func (x *StreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StreamRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StreamRequest) GetLastSeenSequence() int64 {
+	if x != nil {
+		return x.LastSeenSequence
+	}
+	return 0
+}
+
+type ResumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId         string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	ReconnectToken string `protobuf:"bytes,2,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"` // from JoinResponse
+}
+
+func (x *ResumeRequest) Reset() {
+	*x = ResumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRequest) ProtoMessage() {}
+
+func (x *ResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResumeRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ResumeRequest) GetReconnectToken() string {
+	if x != nil {
+		return x.ReconnectToken
+	}
+	return ""
+}
+
+// Spectator watches the game without playing, e.g. on the projector.
+type SpectateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Same as in StreamRequest. Use 0 to receive all events
+	// including the whole transaction history of the game.
+	LastSeenSequence int64 `protobuf:"varint,2,opt,name=last_seen_sequence,json=lastSeenSequence,proto3" json:"last_seen_sequence,omitempty"`
+}
+
+func (x *SpectateRequest) Reset() {
+	*x = SpectateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRequest) ProtoMessage() {}
+
+func (x *SpectateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRequest.ProtoReflect.Descriptor instead.
+func (*SpectateRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SpectateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SpectateRequest) GetLastSeenSequence() int64 {
+	if x != nil {
+		return x.LastSeenSequence
+	}
+	return 0
+}
+
+type GetGameStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGameStateRequest) Reset() {
+	*x = GetGameStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateRequest) ProtoMessage() {}
+
+func (x *GetGameStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateRequest.ProtoReflect.Descriptor instead.
+func (*GetGameStateRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetGameStateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetGameStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// Times are in seconds from now (rounded up). Times of the
+// game, which hasn't started, are the ones from the config.
+type GetGameStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemainingTime   int32 `protobuf:"varint,1,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`         // until the game finishes
+	TheftWaitTime   int32 `protobuf:"varint,2,opt,name=theft_wait_time,json=theftWaitTime,proto3" json:"theft_wait_time,omitempty"`       // until the next theft
+	LotteryWaitTime int32 `protobuf:"varint,3,opt,name=lottery_wait_time,json=lotteryWaitTime,proto3" json:"lottery_wait_time,omitempty"` // until the player can play the lottery again
+	Paused          bool  `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`                                            // times don't run, while the game is paused
+	// current time of the server in milliseconds since the unix epoch
+	ServerTime int64 `protobuf:"varint,5,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+}
+
+func (x *GetGameStateResponse) Reset() {
+	*x = GetGameStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameStateResponse) ProtoMessage() {}
+
+func (x *GetGameStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameStateResponse.ProtoReflect.Descriptor instead.
+func (*GetGameStateResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetGameStateResponse) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetTheftWaitTime() int32 {
+	if x != nil {
+		return x.TheftWaitTime
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetLotteryWaitTime() int32 {
+	if x != nil {
+		return x.LotteryWaitTime
+	}
+	return 0
+}
+
+func (x *GetGameStateResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GetGameStateResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+type GetGameSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGameSummaryRequest) Reset() {
+	*x = GetGameSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameSummaryRequest) ProtoMessage() {}
+
+func (x *GetGameSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetGameSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetGameSummaryRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Summary *GameSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *GetGameSummaryResponse) Reset() {
+	*x = GetGameSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameSummaryResponse) ProtoMessage() {}
+
+func (x *GetGameSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetGameSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetGameSummaryResponse) GetSummary() *GameSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type StreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*StreamResponse_Join_
+	//	*StreamResponse_Leave_
+	//	*StreamResponse_Config
+	//	*StreamResponse_LobbyState_
+	//	*StreamResponse_Countdown_
+	//	*StreamResponse_Paused_
+	//	*StreamResponse_Resumed_
+	//	*StreamResponse_Start_
+	//	*StreamResponse_Finish_
+	//	*StreamResponse_Transaction_
+	//	*StreamResponse_Snapshot_
+	Event isStreamResponse_Event `protobuf_oneof:"event"`
+	// Events of the game are numbered from 1 in the order,
+	// in which they have happened.
+	Sequence int64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *StreamResponse) Reset() {
+	*x = StreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse) ProtoMessage() {}
+
+func (x *StreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse.ProtoReflect.Descriptor instead.
+func (*StreamResponse) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43}
+}
+
+func (m *StreamResponse) GetEvent() isStreamResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetJoin() *StreamResponse_Join {
+	if x, ok := x.GetEvent().(*StreamResponse_Join_); ok {
+		return x.Join
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetLeave() *StreamResponse_Leave {
+	if x, ok := x.GetEvent().(*StreamResponse_Leave_); ok {
+		return x.Leave
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetConfig() *GameConfig {
+	if x, ok := x.GetEvent().(*StreamResponse_Config); ok {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetLobbyState() *StreamResponse_LobbyState {
+	if x, ok := x.GetEvent().(*StreamResponse_LobbyState_); ok {
+		return x.LobbyState
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetCountdown() *StreamResponse_Countdown {
+	if x, ok := x.GetEvent().(*StreamResponse_Countdown_); ok {
+		return x.Countdown
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetPaused() *StreamResponse_Paused {
+	if x, ok := x.GetEvent().(*StreamResponse_Paused_); ok {
+		return x.Paused
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetResumed() *StreamResponse_Resumed {
+	if x, ok := x.GetEvent().(*StreamResponse_Resumed_); ok {
+		return x.Resumed
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetStart() *StreamResponse_Start {
+	if x, ok := x.GetEvent().(*StreamResponse_Start_); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetFinish() *StreamResponse_Finish {
+	if x, ok := x.GetEvent().(*StreamResponse_Finish_); ok {
+		return x.Finish
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetTransaction() *StreamResponse_Transaction {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_); ok {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetSnapshot() *StreamResponse_Snapshot {
+	if x, ok := x.GetEvent().(*StreamResponse_Snapshot_); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *StreamResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type isStreamResponse_Event interface {
+	isStreamResponse_Event()
+}
+
+type StreamResponse_Join_ struct {
+	// Events for game in "Waiting" state.
+	Join *StreamResponse_Join `protobuf:"bytes,1,opt,name=join,proto3,oneof"`
+}
+
+type StreamResponse_Leave_ struct {
+	Leave *StreamResponse_Leave `protobuf:"bytes,2,opt,name=leave,proto3,oneof"`
+}
+
+type StreamResponse_Config struct {
+	// Sent, when the host changes the config of the lobby.
+	Config *GameConfig `protobuf:"bytes,8,opt,name=config,proto3,oneof"`
+}
+
+type StreamResponse_LobbyState_ struct {
+	// Sent, when the host leaves or locks/unlocks the lobby.
+	LobbyState *StreamResponse_LobbyState `protobuf:"bytes,9,opt,name=lobby_state,json=lobbyState,proto3,oneof"`
+}
+
+type StreamResponse_Countdown_ struct {
+	// Sent, when the start countdown begins or is cancelled.
+	Countdown *StreamResponse_Countdown `protobuf:"bytes,10,opt,name=countdown,proto3,oneof"`
+}
+
+type StreamResponse_Paused_ struct {
+	// Sent, when the host pauses or resumes the game.
+	Paused *StreamResponse_Paused `protobuf:"bytes,11,opt,name=paused,proto3,oneof"`
+}
+
+type StreamResponse_Resumed_ struct {
+	Resumed *StreamResponse_Resumed `protobuf:"bytes,12,opt,name=resumed,proto3,oneof"`
+}
+
+type StreamResponse_Start_ struct {
+	// Events for game in "Active" state.
+	Start *StreamResponse_Start `protobuf:"bytes,3,opt,name=start,proto3,oneof"`
+}
+
+type StreamResponse_Finish_ struct {
+	Finish *StreamResponse_Finish `protobuf:"bytes,4,opt,name=finish,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_ struct {
+	Transaction *StreamResponse_Transaction `protobuf:"bytes,5,opt,name=transaction,proto3,oneof"`
+}
+
+type StreamResponse_Snapshot_ struct {
+	// Sent only by Resume as the first event.
+	Snapshot *StreamResponse_Snapshot `protobuf:"bytes,7,opt,name=snapshot,proto3,oneof"`
+}
+
+func (*StreamResponse_Join_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Leave_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Config) isStreamResponse_Event() {}
+
+func (*StreamResponse_LobbyState_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Countdown_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Paused_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Resumed_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Start_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Finish_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Transaction_) isStreamResponse_Event() {}
+
+func (*StreamResponse_Snapshot_) isStreamResponse_Event() {}
+
+type GameSummary_PlayerSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Points   int32  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	// 1 for the most points, players with the same points
+	// share the rank, e.g. 1, 1, 3
+	Rank                  int32 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	CreditsTaken          int32 `protobuf:"varint,5,opt,name=credits_taken,json=creditsTaken,proto3" json:"credits_taken,omitempty"`
+	CreditInterestPaid    int32 `protobuf:"varint,6,opt,name=credit_interest_paid,json=creditInterestPaid,proto3" json:"credit_interest_paid,omitempty"`
+	DepositsMade          int32 `protobuf:"varint,7,opt,name=deposits_made,json=depositsMade,proto3" json:"deposits_made,omitempty"`
+	DepositInterestEarned int32 `protobuf:"varint,8,opt,name=deposit_interest_earned,json=depositInterestEarned,proto3" json:"deposit_interest_earned,omitempty"`
+	TheftLosses           int32 `protobuf:"varint,9,opt,name=theft_losses,json=theftLosses,proto3" json:"theft_losses,omitempty"`
+	LotteryTicketsPaid    int32 `protobuf:"varint,10,opt,name=lottery_tickets_paid,json=lotteryTicketsPaid,proto3" json:"lottery_tickets_paid,omitempty"`
+	LotteryWinnings       int32 `protobuf:"varint,11,opt,name=lottery_winnings,json=lotteryWinnings,proto3" json:"lottery_winnings,omitempty"`
+	// points won above the bids of the answers
+	QuestionBidsWon int32 `protobuf:"varint,12,opt,name=question_bids_won,json=questionBidsWon,proto3" json:"question_bids_won,omitempty"`
+	// bids, which haven't been won back, e.g. of wrong or
+	// expired answers and unanswered questions
+	QuestionBidsLost int32 `protobuf:"varint,13,opt,name=question_bids_lost,json=questionBidsLost,proto3" json:"question_bids_lost,omitempty"`
+}
+
+func (x *GameSummary_PlayerSummary) Reset() {
+	*x = GameSummary_PlayerSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameSummary_PlayerSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSummary_PlayerSummary) ProtoMessage() {}
+
+func (x *GameSummary_PlayerSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSummary_PlayerSummary.ProtoReflect.Descriptor instead.
+func (*GameSummary_PlayerSummary) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *GameSummary_PlayerSummary) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GameSummary_PlayerSummary) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GameSummary_PlayerSummary) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetCreditsTaken() int32 {
+	if x != nil {
+		return x.CreditsTaken
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetCreditInterestPaid() int32 {
+	if x != nil {
+		return x.CreditInterestPaid
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetDepositsMade() int32 {
+	if x != nil {
+		return x.DepositsMade
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetDepositInterestEarned() int32 {
+	if x != nil {
+		return x.DepositInterestEarned
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetTheftLosses() int32 {
+	if x != nil {
+		return x.TheftLosses
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetLotteryTicketsPaid() int32 {
+	if x != nil {
+		return x.LotteryTicketsPaid
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetLotteryWinnings() int32 {
+	if x != nil {
+		return x.LotteryWinnings
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetQuestionBidsWon() int32 {
+	if x != nil {
+		return x.QuestionBidsWon
+	}
+	return 0
+}
+
+func (x *GameSummary_PlayerSummary) GetQuestionBidsLost() int32 {
+	if x != nil {
+		return x.QuestionBidsLost
+	}
+	return 0
+}
+
+type ListLobbiesResponse_Lobby struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JoinCode    string `protobuf:"bytes,1,opt,name=join_code,json=joinCode,proto3" json:"join_code,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PlayerCount int32  `protobuf:"varint,3,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+}
+
+func (x *ListLobbiesResponse_Lobby) Reset() {
+	*x = ListLobbiesResponse_Lobby{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLobbiesResponse_Lobby) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLobbiesResponse_Lobby) ProtoMessage() {}
+
+func (x *ListLobbiesResponse_Lobby) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLobbiesResponse_Lobby.ProtoReflect.Descriptor instead.
+func (*ListLobbiesResponse_Lobby) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *ListLobbiesResponse_Lobby) GetJoinCode() string {
+	if x != nil {
+		return x.JoinCode
+	}
+	return ""
+}
+
+func (x *ListLobbiesResponse_Lobby) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListLobbiesResponse_Lobby) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+type StreamResponse_Join struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *StreamResponse_Join) Reset() {
+	*x = StreamResponse_Join{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Join) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Join) ProtoMessage() {}
+
+func (x *StreamResponse_Join) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Join.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Join) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *StreamResponse_Join) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type StreamResponse_Leave struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kicked bool   `protobuf:"varint,2,opt,name=kicked,proto3" json:"kicked,omitempty"` // true if the player has been kicked by the host
+}
+
+func (x *StreamResponse_Leave) Reset() {
+	*x = StreamResponse_Leave{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Leave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Leave) ProtoMessage() {}
+
+func (x *StreamResponse_Leave) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Leave.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Leave) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 1}
+}
+
+func (x *StreamResponse_Leave) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Leave) GetKicked() bool {
+	if x != nil {
+		return x.Kicked
+	}
+	return false
+}
+
+// Host is the next player in the join order,
+// when the previous host leaves.
+type StreamResponse_LobbyState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostUserId string `protobuf:"bytes,1,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	Locked     bool   `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
+}
+
+func (x *StreamResponse_LobbyState) Reset() {
+	*x = StreamResponse_LobbyState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_LobbyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_LobbyState) ProtoMessage() {}
+
+func (x *StreamResponse_LobbyState) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_LobbyState.ProtoReflect.Descriptor instead.
+func (*StreamResponse_LobbyState) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 2}
+}
+
+func (x *StreamResponse_LobbyState) GetHostUserId() string {
+	if x != nil {
+		return x.HostUserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_LobbyState) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+// Countdown begins, when the game has min players, and is
+// cancelled, when players leave and there are less of them.
+// The game starts, when the remaining time has passed.
+type StreamResponse_Countdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemainingTime int32 `protobuf:"varint,1,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"` // in seconds, 0 if cancelled
+	Cancelled     bool  `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (x *StreamResponse_Countdown) Reset() {
+	*x = StreamResponse_Countdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Countdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Countdown) ProtoMessage() {}
+
+func (x *StreamResponse_Countdown) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Countdown.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Countdown) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 3}
+}
+
+func (x *StreamResponse_Countdown) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_Countdown) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+// Timers of the game don't run, while it is paused.
+// Credits, deposits, lottery and questions are rejected.
+type StreamResponse_Paused struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemainingTime int32 `protobuf:"varint,1,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"` // seconds until the game finishes
+}
+
+func (x *StreamResponse_Paused) Reset() {
+	*x = StreamResponse_Paused{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Paused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Paused) ProtoMessage() {}
+
+func (x *StreamResponse_Paused) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Paused.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Paused) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 4}
+}
+
+func (x *StreamResponse_Paused) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+type StreamResponse_Resumed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemainingTime int32 `protobuf:"varint,1,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"` // seconds until the game finishes
+}
+
+func (x *StreamResponse_Resumed) Reset() {
+	*x = StreamResponse_Resumed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Resumed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Resumed) ProtoMessage() {}
+
+func (x *StreamResponse_Resumed) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Resumed.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Resumed) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 5}
+}
+
+func (x *StreamResponse_Resumed) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+// Times of the server in milliseconds since the unix epoch, so
+// that clients can precisely estimate the remaining time. The end
+// time moves forward, if the game is paused (see Resumed).
+type StreamResponse_Start struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *StreamResponse_Start) Reset() {
+	*x = StreamResponse_Start{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Start) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Start) ProtoMessage() {}
+
+func (x *StreamResponse_Start) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Start.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Start) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 6}
+}
+
+func (x *StreamResponse_Start) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_Start) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// Outstanding credits and deposits are returned with full
+// interest at the finish, so players contain the final points.
+// The jackpot, which hasn't been won, goes back to the bank.
+type StreamResponse_Finish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// empty, if several players share the most points
+	// (see winner_user_ids of the summary)
+	WinnerUserId string `protobuf:"bytes,2,opt,name=winner_user_id,json=winnerUserId,proto3" json:"winner_user_id,omitempty"`
+	// seed of the game random source, which can be
+	// used to reproduce the game
+	RandomSeed int64 `protobuf:"varint,3,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`
+	// lobby with the same config, which players of the game can join
+	// with Rematch during rematch_time seconds, empty if it is disabled
+	RematchGameId string       `protobuf:"bytes,4,opt,name=rematch_game_id,json=rematchGameId,proto3" json:"rematch_game_id,omitempty"`
+	RematchTime   int32        `protobuf:"varint,5,opt,name=rematch_time,json=rematchTime,proto3" json:"rematch_time,omitempty"`
+	Summary       *GameSummary `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+}
+
+func (x *StreamResponse_Finish) Reset() {
+	*x = StreamResponse_Finish{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Finish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Finish) ProtoMessage() {}
+
+func (x *StreamResponse_Finish) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Finish.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Finish) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 7}
+}
+
+func (x *StreamResponse_Finish) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *StreamResponse_Finish) GetWinnerUserId() string {
+	if x != nil {
+		return x.WinnerUserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Finish) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *StreamResponse_Finish) GetRematchGameId() string {
+	if x != nil {
+		return x.RematchGameId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Finish) GetRematchTime() int32 {
+	if x != nil {
+		return x.RematchTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_Finish) GetSummary() *GameSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+// Current state of the game for the resumed player.
+// Its sequence is the sequence of the last event, which
+// is already reflected in the snapshot. All times are
+// in seconds from now (rounded up).
+type StreamResponse_Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string                                         `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username  string                                         `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Started   bool                                           `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
+	Players   []*Player                                      `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	Jackpot   int32                                          `protobuf:"varint,5,opt,name=jackpot,proto3" json:"jackpot,omitempty"`
+	Credits   []*StreamResponse_Snapshot_Loan                `protobuf:"bytes,6,rep,name=credits,proto3" json:"credits,omitempty"`
+	Deposits  []*StreamResponse_Snapshot_Loan                `protobuf:"bytes,7,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	Questions []*StreamResponse_Snapshot_OutstandingQuestion `protobuf:"bytes,8,rep,name=questions,proto3" json:"questions,omitempty"`
+	// time until the player can play the lottery again
+	LotteryWaitTime int32 `protobuf:"varint,9,opt,name=lottery_wait_time,json=lotteryWaitTime,proto3" json:"lottery_wait_time,omitempty"`
+	// time until the game finishes, game duration if it hasn't started
+	RemainingTime int32  `protobuf:"varint,10,opt,name=remaining_time,json=remainingTime,proto3" json:"remaining_time,omitempty"`
+	HostUserId    string `protobuf:"bytes,11,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	Locked        bool   `protobuf:"varint,12,opt,name=locked,proto3" json:"locked,omitempty"`
+	// time until the auto-start, 0 if the countdown hasn't begun
+	StartCountdown int32 `protobuf:"varint,13,opt,name=start_countdown,json=startCountdown,proto3" json:"start_countdown,omitempty"`
+	// times don't run, while the game is paused
+	Paused bool `protobuf:"varint,14,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *StreamResponse_Snapshot) Reset() {
+	*x = StreamResponse_Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Snapshot) ProtoMessage() {}
+
+func (x *StreamResponse_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Snapshot.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Snapshot) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 8}
+}
+
+func (x *StreamResponse_Snapshot) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Snapshot) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StreamResponse_Snapshot) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *StreamResponse_Snapshot) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *StreamResponse_Snapshot) GetJackpot() int32 {
+	if x != nil {
+		return x.Jackpot
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot) GetCredits() []*StreamResponse_Snapshot_Loan {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *StreamResponse_Snapshot) GetDeposits() []*StreamResponse_Snapshot_Loan {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *StreamResponse_Snapshot) GetQuestions() []*StreamResponse_Snapshot_OutstandingQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *StreamResponse_Snapshot) GetLotteryWaitTime() int32 {
+	if x != nil {
+		return x.LotteryWaitTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot) GetRemainingTime() int32 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot) GetHostUserId() string {
+	if x != nil {
+		return x.HostUserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Snapshot) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *StreamResponse_Snapshot) GetStartCountdown() int32 {
+	if x != nil {
+		return x.StartCountdown
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type StreamResponse_Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Server will recalculate the amount
+	// of money for each player and send it
+	// to client. This way, client won't have
+	// to do calculations. It can just update
+	// the fields showing amounts of "points"
+	// for each player.
+	Players []*Player `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Types that are assignable to Event:
+	//	*StreamResponse_Transaction_UseCredit_
+	//	*StreamResponse_Transaction_UseDeposit_
+	//	*StreamResponse_Transaction_ReturnCredit_
+	//	*StreamResponse_Transaction_ReturnDeposit_
+	//	*StreamResponse_Transaction_Theft_
+	//	*StreamResponse_Transaction_Lottery_
+	//	*StreamResponse_Transaction_Question_
+	Event isStreamResponse_Transaction_Event `protobuf_oneof:"event"`
+	// current size of the lottery jackpot
+	Jackpot int32 `protobuf:"varint,9,opt,name=jackpot,proto3" json:"jackpot,omitempty"`
+	// time of the server, when the transaction has happened,
+	// in milliseconds since the unix epoch
+	Time int64 `protobuf:"varint,10,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *StreamResponse_Transaction) Reset() {
+	*x = StreamResponse_Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9}
+}
+
+func (x *StreamResponse_Transaction) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (m *StreamResponse_Transaction) GetEvent() isStreamResponse_Transaction_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetUseCredit() *StreamResponse_Transaction_UseCredit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_UseCredit_); ok {
+		return x.UseCredit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetUseDeposit() *StreamResponse_Transaction_UseDeposit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_UseDeposit_); ok {
+		return x.UseDeposit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetReturnCredit() *StreamResponse_Transaction_ReturnCredit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_ReturnCredit_); ok {
+		return x.ReturnCredit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetReturnDeposit() *StreamResponse_Transaction_ReturnDeposit {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_ReturnDeposit_); ok {
+		return x.ReturnDeposit
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetTheft() *StreamResponse_Transaction_Theft {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Theft_); ok {
+		return x.Theft
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetLottery() *StreamResponse_Transaction_Lottery {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Lottery_); ok {
+		return x.Lottery
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetQuestion() *StreamResponse_Transaction_Question {
+	if x, ok := x.GetEvent().(*StreamResponse_Transaction_Question_); ok {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *StreamResponse_Transaction) GetJackpot() int32 {
+	if x != nil {
+		return x.Jackpot
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type isStreamResponse_Transaction_Event interface {
+	isStreamResponse_Transaction_Event()
+}
+
+type StreamResponse_Transaction_UseCredit_ struct {
+	UseCredit *StreamResponse_Transaction_UseCredit `protobuf:"bytes,2,opt,name=use_credit,json=useCredit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_UseDeposit_ struct {
+	UseDeposit *StreamResponse_Transaction_UseDeposit `protobuf:"bytes,3,opt,name=use_deposit,json=useDeposit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_ReturnCredit_ struct {
+	ReturnCredit *StreamResponse_Transaction_ReturnCredit `protobuf:"bytes,4,opt,name=return_credit,json=returnCredit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_ReturnDeposit_ struct {
+	ReturnDeposit *StreamResponse_Transaction_ReturnDeposit `protobuf:"bytes,5,opt,name=return_deposit,json=returnDeposit,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Theft_ struct {
+	Theft *StreamResponse_Transaction_Theft `protobuf:"bytes,6,opt,name=theft,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Lottery_ struct {
+	Lottery *StreamResponse_Transaction_Lottery `protobuf:"bytes,7,opt,name=lottery,proto3,oneof"`
+}
+
+type StreamResponse_Transaction_Question_ struct {
+	Question *StreamResponse_Transaction_Question `protobuf:"bytes,8,opt,name=question,proto3,oneof"`
+}
+
+func (*StreamResponse_Transaction_UseCredit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_UseDeposit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_ReturnCredit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_ReturnDeposit_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Theft_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Lottery_) isStreamResponse_Transaction_Event() {}
+
+func (*StreamResponse_Transaction_Question_) isStreamResponse_Transaction_Event() {}
+
+type StreamResponse_Snapshot_Loan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value             int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	ValueWithInterest int32 `protobuf:"varint,2,opt,name=value_with_interest,json=valueWithInterest,proto3" json:"value_with_interest,omitempty"` // value, which will be returned
+	ReturnTime        int32 `protobuf:"varint,3,opt,name=return_time,json=returnTime,proto3" json:"return_time,omitempty"`
+}
+
+func (x *StreamResponse_Snapshot_Loan) Reset() {
+	*x = StreamResponse_Snapshot_Loan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Snapshot_Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Snapshot_Loan) ProtoMessage() {}
+
+func (x *StreamResponse_Snapshot_Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Snapshot_Loan.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Snapshot_Loan) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 8, 0}
+}
+
+func (x *StreamResponse_Snapshot_Loan) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot_Loan) GetValueWithInterest() int32 {
+	if x != nil {
+		return x.ValueWithInterest
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot_Loan) GetReturnTime() int32 {
+	if x != nil {
+		return x.ReturnTime
+	}
+	return 0
+}
+
+// Question, which hasn't been answered yet.
+// Fields are the same as in GenerateQuestionResponse.
+type StreamResponse_Snapshot_OutstandingQuestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string       `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Question   string       `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	Answers    []string     `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	Type       QuestionType `protobuf:"varint,4,opt,name=type,proto3,enum=server.QuestionType" json:"type,omitempty"`
+	AnswerMin  int32        `protobuf:"varint,5,opt,name=answer_min,json=answerMin,proto3" json:"answer_min,omitempty"`
+	AnswerMax  int32        `protobuf:"varint,6,opt,name=answer_max,json=answerMax,proto3" json:"answer_max,omitempty"`
+	BidPoints  int32        `protobuf:"varint,7,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
+	AnswerTime int32        `protobuf:"varint,8,opt,name=answer_time,json=answerTime,proto3" json:"answer_time,omitempty"` // time left to answer the question
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) Reset() {
+	*x = StreamResponse_Snapshot_OutstandingQuestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Snapshot_OutstandingQuestion) ProtoMessage() {}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Snapshot_OutstandingQuestion.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Snapshot_OutstandingQuestion) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 8, 1}
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) GetType() QuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return QuestionType_MULTIPLE
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) GetAnswerMin() int32 {
+	if x != nil {
+		return x.AnswerMin
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) GetAnswerMax() int32 {
+	if x != nil {
+		return x.AnswerMax
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) GetBidPoints() int32 {
+	if x != nil {
+		return x.BidPoints
+	}
+	return 0
+}
+
+func (x *StreamResponse_Snapshot_OutstandingQuestion) GetAnswerTime() int32 {
+	if x != nil {
+		return x.AnswerTime
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_UseCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_UseCredit) Reset() {
+	*x = StreamResponse_Transaction_UseCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_UseCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_UseCredit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_UseCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_UseCredit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_UseCredit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9, 0}
+}
+
+func (x *StreamResponse_Transaction_UseCredit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_UseCredit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_UseDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_UseDeposit) Reset() {
+	*x = StreamResponse_Transaction_UseDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_UseDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_UseDeposit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_UseDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_UseDeposit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_UseDeposit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9, 1}
+}
+
+func (x *StreamResponse_Transaction_UseDeposit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_UseDeposit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_ReturnCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_ReturnCredit) Reset() {
+	*x = StreamResponse_Transaction_ReturnCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_ReturnCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_ReturnCredit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_ReturnCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_ReturnCredit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_ReturnCredit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9, 2}
+}
+
+func (x *StreamResponse_Transaction_ReturnCredit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_ReturnCredit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_ReturnDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value  int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) Reset() {
+	*x = StreamResponse_Transaction_ReturnDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_ReturnDeposit) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_ReturnDeposit.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_ReturnDeposit) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9, 3}
+}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_ReturnDeposit) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StreamResponse_Transaction_Theft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RobbedPlayers []*StreamResponse_Transaction_Theft_RobbedPlayer `protobuf:"bytes,1,rep,name=robbed_players,json=robbedPlayers,proto3" json:"robbed_players,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Theft) Reset() {
+	*x = StreamResponse_Transaction_Theft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Theft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Theft) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Theft) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Theft.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Theft) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9, 4}
+}
+
+func (x *StreamResponse_Transaction_Theft) GetRobbedPlayers() []*StreamResponse_Transaction_Theft_RobbedPlayer {
+	if x != nil {
+		return x.RobbedPlayers
+	}
+	return nil
+}
+
+type StreamResponse_Transaction_Lottery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Value       int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	TicketPrice int32  `protobuf:"varint,3,opt,name=ticket_price,json=ticketPrice,proto3" json:"ticket_price,omitempty"`
+	// true if the player has won the jackpot
+	JackpotWon bool `protobuf:"varint,4,opt,name=jackpot_won,json=jackpotWon,proto3" json:"jackpot_won,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Lottery) Reset() {
+	*x = StreamResponse_Transaction_Lottery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Lottery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Lottery) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Lottery) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Lottery.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Lottery) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9, 5}
+}
+
+func (x *StreamResponse_Transaction_Lottery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Lottery) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Lottery) GetTicketPrice() int32 {
+	if x != nil {
+		return x.TicketPrice
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Lottery) GetJackpotWon() bool {
+	if x != nil {
+		return x.JackpotWon
+	}
+	return false
+}
+
+type StreamResponse_Transaction_Question struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId          string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AnswerIsCorrect bool   `protobuf:"varint,2,opt,name=answer_is_correct,json=answerIsCorrect,proto3" json:"answer_is_correct,omitempty"`
+	BidPoints       int32  `protobuf:"varint,3,opt,name=bid_points,json=bidPoints,proto3" json:"bid_points,omitempty"`
+	WinPoints       int32  `protobuf:"varint,4,opt,name=win_points,json=winPoints,proto3" json:"win_points,omitempty"`
+	// true if the question hasn't been answered in time
+	// and the bid has been forfeited.
+	Expired bool `protobuf:"varint,5,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Question) Reset() {
+	*x = StreamResponse_Transaction_Question{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Question) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Question) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Question.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Question) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9, 6}
+}
+
+func (x *StreamResponse_Transaction_Question) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Question) GetAnswerIsCorrect() bool {
+	if x != nil {
+		return x.AnswerIsCorrect
+	}
+	return false
+}
+
+func (x *StreamResponse_Transaction_Question) GetBidPoints() int32 {
+	if x != nil {
+		return x.BidPoints
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Question) GetWinPoints() int32 {
+	if x != nil {
+		return x.WinPoints
+	}
+	return 0
+}
+
+func (x *StreamResponse_Transaction_Question) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type StreamResponse_Transaction_Theft_RobbedPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// value shows how much money has been stolen from the player.
+	Value int32 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) Reset() {
+	*x = StreamResponse_Transaction_Theft_RobbedPlayer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_game_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResponse_Transaction_Theft_RobbedPlayer) ProtoMessage() {}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_game_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResponse_Transaction_Theft_RobbedPlayer.ProtoReflect.Descriptor instead.
+func (*StreamResponse_Transaction_Theft_RobbedPlayer) Descriptor() ([]byte, []int) {
+	return file_game_proto_rawDescGZIP(), []int{43, 9, 4, 0}
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamResponse_Transaction_Theft_RobbedPlayer) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+var File_game_proto protoreflect.FileDescriptor
+
+var file_game_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xf3, 0x04, 0x0a, 0x0b,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x1a, 0xfe, 0x03, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x5f, 0x74,
+	0x61, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x50, 0x61, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x5f, 0x6d, 0x61, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x4d, 0x61, 0x64, 0x65, 0x12,
+	0x36, 0x0a, 0x17, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x66, 0x74,
+	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74,
+	0x68, 0x65, 0x66, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x6f,
+	0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x61,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50, 0x61, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x57,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x69, 0x64, 0x73, 0x5f, 0x77, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x73,
+	0x57, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x69, 0x64, 0x73, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x73, 0x4c, 0x6f, 0x73,
+	0x74, 0x22, 0x46, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xaf, 0x01,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x07, 0x6c, 0x6f, 0x62, 0x62, 0x69,
+	0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x05, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x7a, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x77, 0x69,
+	0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xb6, 0x09, 0x0a, 0x0c,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x61, 0x6e,
+	0x6b, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x62, 0x61, 0x6e, 0x6b, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x65, 0x66,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x74, 0x68, 0x65, 0x66, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x6f,
+	0x74, 0x74, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x57, 0x0a,
+	0x18, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x16,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x65, 0x6c, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x11, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x65, 0x6c, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x5f, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x4a,
+	0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x2f, 0x0a,
+	0x13, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x4a, 0x04,
+	0x08, 0x0f, 0x10, 0x10, 0x22, 0xa5, 0x07, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x62, 0x61, 0x6e, 0x6b, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x50, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x65, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x66, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x68, 0x65, 0x66,
+	0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79,
+	0x4d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x18, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x52, 0x16, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x65, 0x6c,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6c,
+	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x1d, 0x6c, 0x6f, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x1a, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x49,
+	0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6c,
+	0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6c, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6c, 0x6f,
+	0x74, 0x74, 0x65, 0x72, 0x79, 0x4a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x75, 0x0a, 0x15,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a,
+	0x0e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x0b, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6b,
+	0x69, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x4b,
+	0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x4c,
+	0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x6f, 0x63,
+	0x6b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40,
+	0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x44, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x0a, 0x11,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x58, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x0f, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x0e, 0x4c, 0x6f, 0x74,
+	0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x9f, 0x01, 0x0a,
+	0x0f, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x65,
+	0x6c, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0a, 0x63, 0x65, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77,
+	0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x77, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61,
+	0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4c,
+	0x0a, 0x18, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x19,
+	0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69, 0x64,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x28,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x4d, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x4d, 0x61, 0x78, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x69,
+	0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x22, 0x6f, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e,
+	0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0x51, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x58, 0x0a, 0x0f, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x47,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x68, 0x65, 0x66, 0x74,
+	0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x74, 0x68, 0x65, 0x66, 0x74, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x6f, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22,
+	0x89, 0x1d, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x48, 0x00, 0x52,
+	0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x6f, 0x62,
+	0x62, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x40, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x64, 0x6f, 0x77, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77,
+	0x6e, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x06,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x48, 0x00, 0x52, 0x06, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x2e, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e,
+	0x12, 0x26, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x1a, 0x38, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x69,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6b, 0x69, 0x63, 0x6b,
+	0x65, 0x64, 0x1a, 0x46, 0x0a, 0x0a, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x1a, 0x50, 0x0a, 0x09, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x1a, 0x2f, 0x0a, 0x06,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x30, 0x0a,
+	0x07, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x1a,
+	0x41, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x1a, 0xf3, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x28, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x12, 0x26,
+	0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x47, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0xc6, 0x07, 0x0a, 0x08, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x09, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x4f,
+	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72,
+	0x79, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x1a, 0x6d, 0x0a, 0x04, 0x4c,
+	0x6f, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x94, 0x02, 0x0a, 0x13, 0x4f,
+	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x69,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d,
+	0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4d, 0x61,
+	0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x54, 0x69, 0x6d,
+	0x65, 0x1a, 0xde, 0x0a, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x4d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x50, 0x0a, 0x0b, 0x75, 0x73,
+	0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x00,
+	0x52, 0x0a, 0x75, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x56, 0x0a, 0x0d,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x00,
+	0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x40, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x54, 0x68, 0x65, 0x66, 0x74, 0x48, 0x00, 0x52, 0x05, 0x74, 0x68, 0x65, 0x66,
+	0x74, 0x12, 0x46, 0x0a, 0x07, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x48, 0x00,
+	0x52, 0x07, 0x6c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x08, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x1a, 0x3a, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x3b,
+	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x3d, 0x0a, 0x0c, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x3e, 0x0a, 0x0d, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xa4, 0x01, 0x0a, 0x05, 0x54,
+	0x68, 0x65, 0x66, 0x74, 0x12, 0x5c, 0x0a, 0x0e, 0x72, 0x6f, 0x62, 0x62, 0x65, 0x64, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x54, 0x68, 0x65, 0x66, 0x74, 0x2e, 0x52, 0x6f, 0x62, 0x62, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x0d, 0x72, 0x6f, 0x62, 0x62, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0c, 0x52, 0x6f, 0x62, 0x62, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x1a, 0x7c, 0x0a, 0x07, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x5f, 0x77, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6a, 0x61, 0x63, 0x6b, 0x70, 0x6f, 0x74, 0x57, 0x6f, 0x6e, 0x1a,
+	0xa7, 0x01, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x5f,
+	0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x49, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x69, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x34, 0x0a, 0x12, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x41, 0x53, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
+	0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x52, 0x44, 0x10,
+	0x02, 0x2a, 0x36, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x45, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x4e, 0x55, 0x4d, 0x45, 0x52, 0x49, 0x43, 0x10, 0x02, 0x32, 0xe4, 0x0b, 0x0a, 0x04, 0x47, 0x61,
+	0x6d, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x1a,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f,
+	0x62, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x69, 0x65,
+	0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x6f, 0x62, 0x62, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x62, 0x62, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x07,
+	0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x62, 0x62,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x04, 0x4b, 0x69,
+	0x63, 0x6b, 0x12, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4b, 0x69, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x4b, 0x69, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x42, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x12, 0x18, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x45, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x07, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x11, 0x4c, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x74, 0x74,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+	0x74, 0x74, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x3b, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x3f, 0x0a, 0x08, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_game_proto_rawDescOnce sync.Once
+	file_game_proto_rawDescData = file_game_proto_rawDesc
+)
+
+func file_game_proto_rawDescGZIP() []byte {
+	file_game_proto_rawDescOnce.Do(func() {
+		file_game_proto_rawDescData = protoimpl.X.CompressGZIP(file_game_proto_rawDescData)
+	})
+	return file_game_proto_rawDescData
+}
+
+var file_game_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_game_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_game_proto_goTypes = []interface{}{
+	(QuestionDifficulty)(0),                               // 0: server.QuestionDifficulty
+	(QuestionType)(0),                                     // 1: server.QuestionType
+	(*Player)(nil),                                        // 2: server.Player
+	(*GameSummary)(nil),                                   // 3: server.GameSummary
+	(*JoinRequest)(nil),                                   // 4: server.JoinRequest
+	(*CreateLobbyRequest)(nil),                            // 5: server.CreateLobbyRequest
+	(*ListLobbiesRequest)(nil),                            // 6: server.ListLobbiesRequest
+	(*ListLobbiesResponse)(nil),                           // 7: server.ListLobbiesResponse
+	(*QuestionWinPercentage)(nil),                         // 8: server.QuestionWinPercentage
+	(*JoinResponse)(nil),                                  // 9: server.JoinResponse
+	(*GameConfig)(nil),                                    // 10: server.GameConfig
+	(*ConfigureLobbyRequest)(nil),                         // 11: server.ConfigureLobbyRequest
+	(*ConfigureLobbyResponse)(nil),                        // 12: server.ConfigureLobbyResponse
+	(*RematchRequest)(nil),                                // 13: server.RematchRequest
+	(*LeaveRequest)(nil),                                  // 14: server.LeaveRequest
+	(*LeaveResponse)(nil),                                 // 15: server.LeaveResponse
+	(*KickRequest)(nil),                                   // 16: server.KickRequest
+	(*KickResponse)(nil),                                  // 17: server.KickResponse
+	(*LockLobbyRequest)(nil),                              // 18: server.LockLobbyRequest
+	(*LockLobbyResponse)(nil),                             // 19: server.LockLobbyResponse
+	(*StartRequest)(nil),                                  // 20: server.StartRequest
+	(*StartResponse)(nil),                                 // 21: server.StartResponse
+	(*PauseGameRequest)(nil),                              // 22: server.PauseGameRequest
+	(*PauseGameResponse)(nil),                             // 23: server.PauseGameResponse
+	(*ResumeGameRequest)(nil),                             // 24: server.ResumeGameRequest
+	(*ResumeGameResponse)(nil),                            // 25: server.ResumeGameResponse
+	(*CreditRequest)(nil),                                 // 26: server.CreditRequest
+	(*CreditResponse)(nil),                                // 27: server.CreditResponse
+	(*DepositRequest)(nil),                                // 28: server.DepositRequest
+	(*DepositResponse)(nil),                               // 29: server.DepositResponse
+	(*LotteryRequest)(nil),                                // 30: server.LotteryRequest
+	(*LotteryResponse)(nil),                               // 31: server.LotteryResponse
+	(*LotteryCommitmentRequest)(nil),                      // 32: server.LotteryCommitmentRequest
+	(*LotteryCommitmentResponse)(nil),                     // 33: server.LotteryCommitmentResponse
+	(*GenerateQuestionRequest)(nil),                       // 34: server.GenerateQuestionRequest
+	(*GenerateQuestionResponse)(nil),                      // 35: server.GenerateQuestionResponse
+	(*AnswerQuestionRequest)(nil),                         // 36: server.AnswerQuestionRequest
+	(*AnswerQuestionResponse)(nil),                        // 37: server.AnswerQuestionResponse
+	(*StreamRequest)(nil),                                 // 38: server.StreamRequest
+	(*ResumeRequest)(nil),                                 // 39: server.ResumeRequest
+	(*SpectateRequest)(nil),                               // 40: server.SpectateRequest
+	(*GetGameStateRequest)(nil),                           // 41: server.GetGameStateRequest
+	(*GetGameStateResponse)(nil),                          // 42: server.GetGameStateResponse
+	(*GetGameSummaryRequest)(nil),                         // 43: server.GetGameSummaryRequest
+	(*GetGameSummaryResponse)(nil),                        // 44: server.GetGameSummaryResponse
+	(*StreamResponse)(nil),                                // 45: server.StreamResponse
+	(*GameSummary_PlayerSummary)(nil),                     // 46: server.GameSummary.PlayerSummary
+	(*ListLobbiesResponse_Lobby)(nil),                     // 47: server.ListLobbiesResponse.Lobby
+	(*StreamResponse_Join)(nil),                           // 48: server.StreamResponse.Join
+	(*StreamResponse_Leave)(nil),                          // 49: server.StreamResponse.Leave
+	(*StreamResponse_LobbyState)(nil),                     // 50: server.StreamResponse.LobbyState
+	(*StreamResponse_Countdown)(nil),                      // 51: server.StreamResponse.Countdown
+	(*StreamResponse_Paused)(nil),                         // 52: server.StreamResponse.Paused
+	(*StreamResponse_Resumed)(nil),                        // 53: server.StreamResponse.Resumed
+	(*StreamResponse_Start)(nil),                          // 54: server.StreamResponse.Start
+	(*StreamResponse_Finish)(nil),                         // 55: server.StreamResponse.Finish
+	(*StreamResponse_Snapshot)(nil),                       // 56: server.StreamResponse.Snapshot
+	(*StreamResponse_Transaction)(nil),                    // 57: server.StreamResponse.Transaction
+	(*StreamResponse_Snapshot_Loan)(nil),                  // 58: server.StreamResponse.Snapshot.Loan
+	(*StreamResponse_Snapshot_OutstandingQuestion)(nil),   // 59: server.StreamResponse.Snapshot.OutstandingQuestion
+	(*StreamResponse_Transaction_UseCredit)(nil),          // 60: server.StreamResponse.Transaction.UseCredit
+	(*StreamResponse_Transaction_UseDeposit)(nil),         // 61: server.StreamResponse.Transaction.UseDeposit
+	(*StreamResponse_Transaction_ReturnCredit)(nil),       // 62: server.StreamResponse.Transaction.ReturnCredit
+	(*StreamResponse_Transaction_ReturnDeposit)(nil),      // 63: server.StreamResponse.Transaction.ReturnDeposit
+	(*StreamResponse_Transaction_Theft)(nil),              // 64: server.StreamResponse.Transaction.Theft
+	(*StreamResponse_Transaction_Lottery)(nil),            // 65: server.StreamResponse.Transaction.Lottery
+	(*StreamResponse_Transaction_Question)(nil),           // 66: server.StreamResponse.Transaction.Question
+	(*StreamResponse_Transaction_Theft_RobbedPlayer)(nil), // 67: server.StreamResponse.Transaction.Theft.RobbedPlayer
+}
+var file_game_proto_depIdxs = []int32{
+	46, // 0: server.GameSummary.players:type_name -> server.GameSummary.PlayerSummary
+	47, // 1: server.ListLobbiesResponse.lobbies:type_name -> server.ListLobbiesResponse.Lobby
+	0,  // 2: server.QuestionWinPercentage.difficulty:type_name -> server.QuestionDifficulty
+	2,  // 3: server.JoinResponse.players:type_name -> server.Player
+	8,  // 4: server.JoinResponse.question_win_percentages:type_name -> server.QuestionWinPercentage
+	8,  // 5: server.GameConfig.question_win_percentages:type_name -> server.QuestionWinPercentage
+	10, // 6: server.ConfigureLobbyRequest.config:type_name -> server.GameConfig
+	0,  // 7: server.GenerateQuestionRequest.difficulty:type_name -> server.QuestionDifficulty
+	1,  // 8: server.GenerateQuestionRequest.type:type_name -> server.QuestionType
+	1,  // 9: server.GenerateQuestionResponse.type:type_name -> server.QuestionType
+	3,  // 10: server.GetGameSummaryResponse.summary:type_name -> server.GameSummary
+	48, // 11: server.StreamResponse.join:type_name -> server.StreamResponse.Join
+	49, // 12: server.StreamResponse.leave:type_name -> server.StreamResponse.Leave
+	10, // 13: server.StreamResponse.config:type_name -> server.GameConfig
+	50, // 14: server.StreamResponse.lobby_state:type_name -> server.StreamResponse.LobbyState
+	51, // 15: server.StreamResponse.countdown:type_name -> server.StreamResponse.Countdown
+	52, // 16: server.StreamResponse.paused:type_name -> server.StreamResponse.Paused
+	53, // 17: server.StreamResponse.resumed:type_name -> server.StreamResponse.Resumed
+	54, // 18: server.StreamResponse.start:type_name -> server.StreamResponse.Start
+	55, // 19: server.StreamResponse.finish:type_name -> server.StreamResponse.Finish
+	57, // 20: server.StreamResponse.transaction:type_name -> server.StreamResponse.Transaction
+	56, // 21: server.StreamResponse.snapshot:type_name -> server.StreamResponse.Snapshot
+	2,  // 22: server.StreamResponse.Join.player:type_name -> server.Player
+	2,  // 23: server.StreamResponse.Finish.players:type_name -> server.Player
+	3,  // 24: server.StreamResponse.Finish.summary:type_name -> server.GameSummary
+	2,  // 25: server.StreamResponse.Snapshot.players:type_name -> server.Player
+	58, // 26: server.StreamResponse.Snapshot.credits:type_name -> server.StreamResponse.Snapshot.Loan
+	58, // 27: server.StreamResponse.Snapshot.deposits:type_name -> server.StreamResponse.Snapshot.Loan
+	59, // 28: server.StreamResponse.Snapshot.questions:type_name -> server.StreamResponse.Snapshot.OutstandingQuestion
+	2,  // 29: server.StreamResponse.Transaction.players:type_name -> server.Player
+	60, // 30: server.StreamResponse.Transaction.use_credit:type_name -> server.StreamResponse.Transaction.UseCredit
+	61, // 31: server.StreamResponse.Transaction.use_deposit:type_name -> server.StreamResponse.Transaction.UseDeposit
+	62, // 32: server.StreamResponse.Transaction.return_credit:type_name -> server.StreamResponse.Transaction.ReturnCredit
+	63, // 33: server.StreamResponse.Transaction.return_deposit:type_name -> server.StreamResponse.Transaction.ReturnDeposit
+	64, // 34: server.StreamResponse.Transaction.theft:type_name -> server.StreamResponse.Transaction.Theft
+	65, // 35: server.StreamResponse.Transaction.lottery:type_name -> server.StreamResponse.Transaction.Lottery
+	66, // 36: server.StreamResponse.Transaction.question:type_name -> server.StreamResponse.Transaction.Question
+	1,  // 37: server.StreamResponse.Snapshot.OutstandingQuestion.type:type_name -> server.QuestionType
+	67, // 38: server.StreamResponse.Transaction.Theft.robbed_players:type_name -> server.StreamResponse.Transaction.Theft.RobbedPlayer
+	4,  // 39: server.Game.Join:input_type -> server.JoinRequest
+	14, // 40: server.Game.Leave:input_type -> server.LeaveRequest
+	5,  // 41: server.Game.CreateLobby:input_type -> server.CreateLobbyRequest
+	6,  // 42: server.Game.ListLobbies:input_type -> server.ListLobbiesRequest
+	13, // 43: server.Game.Rematch:input_type -> server.RematchRequest
+	11, // 44: server.Game.ConfigureLobby:input_type -> server.ConfigureLobbyRequest
+	16, // 45: server.Game.Kick:input_type -> server.KickRequest
+	18, // 46: server.Game.LockLobby:input_type -> server.LockLobbyRequest
+	20, // 47: server.Game.Start:input_type -> server.StartRequest
+	22, // 48: server.Game.PauseGame:input_type -> server.PauseGameRequest
+	24, // 49: server.Game.ResumeGame:input_type -> server.ResumeGameRequest
+	26, // 50: server.Game.Credit:input_type -> server.CreditRequest
+	28, // 51: server.Game.Deposit:input_type -> server.DepositRequest
+	30, // 52: server.Game.Lottery:input_type -> server.LotteryRequest
+	32, // 53: server.Game.LotteryCommitment:input_type -> server.LotteryCommitmentRequest
+	34, // 54: server.Game.GenerateQuestion:input_type -> server.GenerateQuestionRequest
+	36, // 55: server.Game.AnswerQuestion:input_type -> server.AnswerQuestionRequest
+	41, // 56: server.Game.GetGameState:input_type -> server.GetGameStateRequest
+	43, // 57: server.Game.GetGameSummary:input_type -> server.GetGameSummaryRequest
+	38, // 58: server.Game.Stream:input_type -> server.StreamRequest
+	39, // 59: server.Game.Resume:input_type -> server.ResumeRequest
+	40, // 60: server.Game.Spectate:input_type -> server.SpectateRequest
+	9,  // 61: server.Game.Join:output_type -> server.JoinResponse
+	15, // 62: server.Game.Leave:output_type -> server.LeaveResponse
+	9,  // 63: server.Game.CreateLobby:output_type -> server.JoinResponse
+	7,  // 64: server.Game.ListLobbies:output_type -> server.ListLobbiesResponse
+	9,  // 65: server.Game.Rematch:output_type -> server.JoinResponse
+	12, // 66: server.Game.ConfigureLobby:output_type -> server.ConfigureLobbyResponse
+	17, // 67: server.Game.Kick:output_type -> server.KickResponse
+	19, // 68: server.Game.LockLobby:output_type -> server.LockLobbyResponse
+	21, // 69: server.Game.Start:output_type -> server.StartResponse
+	23, // 70: server.Game.PauseGame:output_type -> server.PauseGameResponse
+	25, // 71: server.Game.ResumeGame:output_type -> server.ResumeGameResponse
+	27, // 72: server.Game.Credit:output_type -> server.CreditResponse
+	29, // 73: server.Game.Deposit:output_type -> server.DepositResponse
+	31, // 74: server.Game.Lottery:output_type -> server.LotteryResponse
+	33, // 75: server.Game.LotteryCommitment:output_type -> server.LotteryCommitmentResponse
+	35, // 76: server.Game.GenerateQuestion:output_type -> server.GenerateQuestionResponse
+	37, // 77: server.Game.AnswerQuestion:output_type -> server.AnswerQuestionResponse
+	42, // 78: server.Game.GetGameState:output_type -> server.GetGameStateResponse
+	44, // 79: server.Game.GetGameSummary:output_type -> server.GetGameSummaryResponse
+	45, // 80: server.Game.Stream:output_type -> server.StreamResponse
+	45, // 81: server.Game.Resume:output_type -> server.StreamResponse
+	45, // 82: server.Game.Spectate:output_type -> server.StreamResponse
+	61, // [61:83] is the sub-list for method output_type
+	39, // [39:61] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_game_proto_init() }
+func file_game_proto_init() {
+	if File_game_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_game_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Player); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLobbiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLobbiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionWinPercentage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigureLobbyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockLobbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockLobbyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeGameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2486,8 +6135,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRequest); i {
+		file_game_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotteryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2498,8 +6147,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinResponse); i {
+		file_game_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotteryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2510,8 +6159,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveRequest); i {
+		file_game_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotteryCommitmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2522,8 +6171,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveResponse); i {
+		file_game_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LotteryCommitmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2534,8 +6183,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartRequest); i {
+		file_game_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateQuestionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2546,8 +6195,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartResponse); i {
+		file_game_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateQuestionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2558,8 +6207,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreditRequest); i {
+		file_game_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerQuestionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2570,8 +6219,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreditResponse); i {
+		file_game_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerQuestionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2582,8 +6231,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
+		file_game_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2594,8 +6243,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositResponse); i {
+		file_game_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2606,8 +6255,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LotteryRequest); i {
+		file_game_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2618,8 +6267,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LotteryResponse); i {
+		file_game_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2630,8 +6279,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateQuestionRequest); i {
+		file_game_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2642,8 +6291,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateQuestionResponse); i {
+		file_game_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2654,8 +6303,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnswerQuestionRequest); i {
+		file_game_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2666,8 +6315,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnswerQuestionResponse); i {
+		file_game_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2678,8 +6327,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamRequest); i {
+		file_game_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameSummary_PlayerSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2690,8 +6339,8 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamResponse); i {
+		file_game_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLobbiesResponse_Lobby); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2702,7 +6351,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Join); i {
 			case 0:
 				return &v.state
@@ -2714,7 +6363,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Leave); i {
 			case 0:
 				return &v.state
@@ -2726,7 +6375,55 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_LobbyState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Countdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Paused); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Resumed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Start); i {
 			case 0:
 				return &v.state
@@ -2738,7 +6435,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Finish); i {
 			case 0:
 				return &v.state
@@ -2750,7 +6447,19 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction); i {
 			case 0:
 				return &v.state
@@ -2762,7 +6471,31 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Snapshot_Loan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamResponse_Snapshot_OutstandingQuestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_game_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction_UseCredit); i {
 			case 0:
 				return &v.state
@@ -2774,7 +6507,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction_UseDeposit); i {
 			case 0:
 				return &v.state
@@ -2786,7 +6519,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction_ReturnCredit); i {
 			case 0:
 				return &v.state
@@ -2798,7 +6531,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction_ReturnDeposit); i {
 			case 0:
 				return &v.state
@@ -2810,7 +6543,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction_Theft); i {
 			case 0:
 				return &v.state
@@ -2822,7 +6555,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction_Lottery); i {
 			case 0:
 				return &v.state
@@ -2834,7 +6567,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction_Question); i {
 			case 0:
 				return &v.state
@@ -2846,7 +6579,7 @@ func file_game_proto_init() {
 				return nil
 			}
 		}
-		file_game_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_game_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamResponse_Transaction_Theft_RobbedPlayer); i {
 			case 0:
 				return &v.state
@@ -2859,14 +6592,20 @@ func file_game_proto_init() {
 			}
 		}
 	}
-	file_game_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_game_proto_msgTypes[43].OneofWrappers = []interface{}{
 		(*StreamResponse_Join_)(nil),
 		(*StreamResponse_Leave_)(nil),
+		(*StreamResponse_Config)(nil),
+		(*StreamResponse_LobbyState_)(nil),
+		(*StreamResponse_Countdown_)(nil),
+		(*StreamResponse_Paused_)(nil),
+		(*StreamResponse_Resumed_)(nil),
 		(*StreamResponse_Start_)(nil),
 		(*StreamResponse_Finish_)(nil),
 		(*StreamResponse_Transaction_)(nil),
+		(*StreamResponse_Snapshot_)(nil),
 	}
-	file_game_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_game_proto_msgTypes[55].OneofWrappers = []interface{}{
 		(*StreamResponse_Transaction_UseCredit_)(nil),
 		(*StreamResponse_Transaction_UseDeposit_)(nil),
 		(*StreamResponse_Transaction_ReturnCredit_)(nil),
@@ -2880,13 +6619,14 @@ func file_game_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_game_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   32,
+			NumEnums:      2,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_game_proto_goTypes,
 		DependencyIndexes: file_game_proto_depIdxs,
+		EnumInfos:         file_game_proto_enumTypes,
 		MessageInfos:      file_game_proto_msgTypes,
 	}.Build()
 	File_game_proto = out.File
@@ -2919,17 +6659,59 @@ type GameClient interface {
 	// users cannot leave.
 	Join(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinResponse, error)
 	Leave(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*LeaveResponse, error)
-	// One of the users will start the game. We cannot automatically
+	// Lobbies are separate waiting games, which are joined
+	// with a short code instead of the quick match.
+	// The creator joins the lobby right away.
+	CreateLobby(ctx context.Context, in *CreateLobbyRequest, opts ...grpc.CallOption) (*JoinResponse, error)
+	ListLobbies(ctx context.Context, in *ListLobbiesRequest, opts ...grpc.CallOption) (*ListLobbiesResponse, error)
+	// Players of the finished game can join the private lobby with the
+	// same config during the rematch time (see Finish). The player, who
+	// joins the rematch first, is its host. Returns the same response as
+	// Join with the new user id.
+	Rematch(ctx context.Context, in *RematchRequest, opts ...grpc.CallOption) (*JoinResponse, error)
+	// Replaces the config of the lobby. The config is validated in the
+	// same way as the config of the server. Players, who join after it,
+	// receive the new config in JoinResponse.
+	ConfigureLobby(ctx context.Context, in *ConfigureLobbyRequest, opts ...grpc.CallOption) (*ConfigureLobbyResponse, error)
+	// The first player, who joins the game (e.g. the creator of the lobby),
+	// is its host. When the host leaves, the next player becomes the host.
+	// Kicked player receives the Leave event, and then the stream is closed.
+	Kick(ctx context.Context, in *KickRequest, opts ...grpc.CallOption) (*KickResponse, error)
+	LockLobby(ctx context.Context, in *LockLobbyRequest, opts ...grpc.CallOption) (*LockLobbyResponse, error)
+	// The host will start the game. We cannot automatically
 	// start the game, since it is not some popular project with
 	// a constant flow of users - there may not be enough users in
 	// a game.
 	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
+	// The host can pause the game, e.g. for a class discussion. Points
+	// can't be moved until the host resumes the game. ResumeGame is not
+	// the same as Resume, which continues the game of a disconnected player.
+	PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*PauseGameResponse, error)
+	ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*ResumeGameResponse, error)
 	Credit(ctx context.Context, in *CreditRequest, opts ...grpc.CallOption) (*CreditResponse, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error)
 	Lottery(ctx context.Context, in *LotteryRequest, opts ...grpc.CallOption) (*LotteryResponse, error)
+	// Commits the board for the next lottery of the player before
+	// the cell is chosen. The board and salt are revealed in LotteryResponse,
+	// so that the player can check that the board hasn't been changed.
+	LotteryCommitment(ctx context.Context, in *LotteryCommitmentRequest, opts ...grpc.CallOption) (*LotteryCommitmentResponse, error)
 	GenerateQuestion(ctx context.Context, in *GenerateQuestionRequest, opts ...grpc.CallOption) (*GenerateQuestionResponse, error)
 	AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerQuestionResponse, error)
+	// Returns the current timers of the game, e.g. to correct
+	// the countdown of the client.
+	GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error)
+	// Returns the same summary as in the Finish event. It is
+	// available during an hour after the game has finished.
+	GetGameSummary(ctx context.Context, in *GetGameSummaryRequest, opts ...grpc.CallOption) (*GetGameSummaryResponse, error)
 	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Game_StreamClient, error)
+	// Resume continues the game of the player, who has lost the state,
+	// e.g. after the page is refreshed. First, the snapshot of the game is
+	// sent. Then, the stream continues in the same way as Stream.
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (Game_ResumeClient, error)
+	// Spectate streams events of the waiting or active game in the same
+	// way as Stream, but the spectator doesn't join the game, i.e. it
+	// doesn't have points and isn't sent in the list of players.
+	Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (Game_SpectateClient, error)
 }
 
 type gameClient struct {
@@ -2958,6 +6740,60 @@ func (c *gameClient) Leave(ctx context.Context, in *LeaveRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *gameClient) CreateLobby(ctx context.Context, in *CreateLobbyRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
+	out := new(JoinResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/CreateLobby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ListLobbies(ctx context.Context, in *ListLobbiesRequest, opts ...grpc.CallOption) (*ListLobbiesResponse, error) {
+	out := new(ListLobbiesResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ListLobbies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Rematch(ctx context.Context, in *RematchRequest, opts ...grpc.CallOption) (*JoinResponse, error) {
+	out := new(JoinResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Rematch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ConfigureLobby(ctx context.Context, in *ConfigureLobbyRequest, opts ...grpc.CallOption) (*ConfigureLobbyResponse, error) {
+	out := new(ConfigureLobbyResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ConfigureLobby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Kick(ctx context.Context, in *KickRequest, opts ...grpc.CallOption) (*KickResponse, error) {
+	out := new(KickResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Kick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) LockLobby(ctx context.Context, in *LockLobbyRequest, opts ...grpc.CallOption) (*LockLobbyResponse, error) {
+	out := new(LockLobbyResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/LockLobby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gameClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
 	out := new(StartResponse)
 	err := c.cc.Invoke(ctx, "/server.Game/Start", in, out, opts...)
@@ -2967,6 +6803,24 @@ func (c *gameClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *gameClient) PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*PauseGameResponse, error) {
+	out := new(PauseGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/PauseGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*ResumeGameResponse, error) {
+	out := new(ResumeGameResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/ResumeGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gameClient) Credit(ctx context.Context, in *CreditRequest, opts ...grpc.CallOption) (*CreditResponse, error) {
 	out := new(CreditResponse)
 	err := c.cc.Invoke(ctx, "/server.Game/Credit", in, out, opts...)
@@ -2985,39 +6839,130 @@ func (c *gameClient) Deposit(ctx context.Context, in *DepositRequest, opts ...gr
 	return out, nil
 }
 
-func (c *gameClient) Lottery(ctx context.Context, in *LotteryRequest, opts ...grpc.CallOption) (*LotteryResponse, error) {
-	out := new(LotteryResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/Lottery", in, out, opts...)
-	if err != nil {
+func (c *gameClient) Lottery(ctx context.Context, in *LotteryRequest, opts ...grpc.CallOption) (*LotteryResponse, error) {
+	out := new(LotteryResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/Lottery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) LotteryCommitment(ctx context.Context, in *LotteryCommitmentRequest, opts ...grpc.CallOption) (*LotteryCommitmentResponse, error) {
+	out := new(LotteryCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/LotteryCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GenerateQuestion(ctx context.Context, in *GenerateQuestionRequest, opts ...grpc.CallOption) (*GenerateQuestionResponse, error) {
+	out := new(GenerateQuestionResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GenerateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerQuestionResponse, error) {
+	out := new(AnswerQuestionResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/AnswerQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetGameState(ctx context.Context, in *GetGameStateRequest, opts ...grpc.CallOption) (*GetGameStateResponse, error) {
+	out := new(GetGameStateResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetGameState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) GetGameSummary(ctx context.Context, in *GetGameSummaryRequest, opts ...grpc.CallOption) (*GetGameSummaryResponse, error) {
+	out := new(GetGameSummaryResponse)
+	err := c.cc.Invoke(ctx, "/server.Game/GetGameSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Game_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[0], "/server.Game/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Game_StreamClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type gameStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameStreamClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *gameClient) GenerateQuestion(ctx context.Context, in *GenerateQuestionRequest, opts ...grpc.CallOption) (*GenerateQuestionResponse, error) {
-	out := new(GenerateQuestionResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/GenerateQuestion", in, out, opts...)
+func (c *gameClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (Game_ResumeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[1], "/server.Game/Resume", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &gameResumeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *gameClient) AnswerQuestion(ctx context.Context, in *AnswerQuestionRequest, opts ...grpc.CallOption) (*AnswerQuestionResponse, error) {
-	out := new(AnswerQuestionResponse)
-	err := c.cc.Invoke(ctx, "/server.Game/AnswerQuestion", in, out, opts...)
-	if err != nil {
+type Game_ResumeClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type gameResumeClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameResumeClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *gameClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Game_StreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[0], "/server.Game/Stream", opts...)
+func (c *gameClient) Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (Game_SpectateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Game_serviceDesc.Streams[2], "/server.Game/Spectate", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &gameStreamClient{stream}
+	x := &gameSpectateClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -3027,16 +6972,16 @@ func (c *gameClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc
 	return x, nil
 }
 
-type Game_StreamClient interface {
+type Game_SpectateClient interface {
 	Recv() (*StreamResponse, error)
 	grpc.ClientStream
 }
 
-type gameStreamClient struct {
+type gameSpectateClient struct {
 	grpc.ClientStream
 }
 
-func (x *gameStreamClient) Recv() (*StreamResponse, error) {
+func (x *gameSpectateClient) Recv() (*StreamResponse, error) {
 	m := new(StreamResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
@@ -3058,17 +7003,59 @@ type GameServer interface {
 	// users cannot leave.
 	Join(context.Context, *JoinRequest) (*JoinResponse, error)
 	Leave(context.Context, *LeaveRequest) (*LeaveResponse, error)
-	// One of the users will start the game. We cannot automatically
+	// Lobbies are separate waiting games, which are joined
+	// with a short code instead of the quick match.
+	// The creator joins the lobby right away.
+	CreateLobby(context.Context, *CreateLobbyRequest) (*JoinResponse, error)
+	ListLobbies(context.Context, *ListLobbiesRequest) (*ListLobbiesResponse, error)
+	// Players of the finished game can join the private lobby with the
+	// same config during the rematch time (see Finish). The player, who
+	// joins the rematch first, is its host. Returns the same response as
+	// Join with the new user id.
+	Rematch(context.Context, *RematchRequest) (*JoinResponse, error)
+	// Replaces the config of the lobby. The config is validated in the
+	// same way as the config of the server. Players, who join after it,
+	// receive the new config in JoinResponse.
+	ConfigureLobby(context.Context, *ConfigureLobbyRequest) (*ConfigureLobbyResponse, error)
+	// The first player, who joins the game (e.g. the creator of the lobby),
+	// is its host. When the host leaves, the next player becomes the host.
+	// Kicked player receives the Leave event, and then the stream is closed.
+	Kick(context.Context, *KickRequest) (*KickResponse, error)
+	LockLobby(context.Context, *LockLobbyRequest) (*LockLobbyResponse, error)
+	// The host will start the game. We cannot automatically
 	// start the game, since it is not some popular project with
 	// a constant flow of users - there may not be enough users in
 	// a game.
 	Start(context.Context, *StartRequest) (*StartResponse, error)
+	// The host can pause the game, e.g. for a class discussion. Points
+	// can't be moved until the host resumes the game. ResumeGame is not
+	// the same as Resume, which continues the game of a disconnected player.
+	PauseGame(context.Context, *PauseGameRequest) (*PauseGameResponse, error)
+	ResumeGame(context.Context, *ResumeGameRequest) (*ResumeGameResponse, error)
 	Credit(context.Context, *CreditRequest) (*CreditResponse, error)
 	Deposit(context.Context, *DepositRequest) (*DepositResponse, error)
 	Lottery(context.Context, *LotteryRequest) (*LotteryResponse, error)
+	// Commits the board for the next lottery of the player before
+	// the cell is chosen. The board and salt are revealed in LotteryResponse,
+	// so that the player can check that the board hasn't been changed.
+	LotteryCommitment(context.Context, *LotteryCommitmentRequest) (*LotteryCommitmentResponse, error)
 	GenerateQuestion(context.Context, *GenerateQuestionRequest) (*GenerateQuestionResponse, error)
 	AnswerQuestion(context.Context, *AnswerQuestionRequest) (*AnswerQuestionResponse, error)
+	// Returns the current timers of the game, e.g. to correct
+	// the countdown of the client.
+	GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error)
+	// Returns the same summary as in the Finish event. It is
+	// available during an hour after the game has finished.
+	GetGameSummary(context.Context, *GetGameSummaryRequest) (*GetGameSummaryResponse, error)
 	Stream(*StreamRequest, Game_StreamServer) error
+	// Resume continues the game of the player, who has lost the state,
+	// e.g. after the page is refreshed. First, the snapshot of the game is
+	// sent. Then, the stream continues in the same way as Stream.
+	Resume(*ResumeRequest, Game_ResumeServer) error
+	// Spectate streams events of the waiting or active game in the same
+	// way as Stream, but the spectator doesn't join the game, i.e. it
+	// doesn't have points and isn't sent in the list of players.
+	Spectate(*SpectateRequest, Game_SpectateServer) error
 }
 
 // UnimplementedGameServer can be embedded to have forward compatible implementations.
@@ -3081,9 +7068,33 @@ func (*UnimplementedGameServer) Join(context.Context, *JoinRequest) (*JoinRespon
 func (*UnimplementedGameServer) Leave(context.Context, *LeaveRequest) (*LeaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Leave not implemented")
 }
+func (*UnimplementedGameServer) CreateLobby(context.Context, *CreateLobbyRequest) (*JoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLobby not implemented")
+}
+func (*UnimplementedGameServer) ListLobbies(context.Context, *ListLobbiesRequest) (*ListLobbiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLobbies not implemented")
+}
+func (*UnimplementedGameServer) Rematch(context.Context, *RematchRequest) (*JoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rematch not implemented")
+}
+func (*UnimplementedGameServer) ConfigureLobby(context.Context, *ConfigureLobbyRequest) (*ConfigureLobbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureLobby not implemented")
+}
+func (*UnimplementedGameServer) Kick(context.Context, *KickRequest) (*KickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Kick not implemented")
+}
+func (*UnimplementedGameServer) LockLobby(context.Context, *LockLobbyRequest) (*LockLobbyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockLobby not implemented")
+}
 func (*UnimplementedGameServer) Start(context.Context, *StartRequest) (*StartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
 }
+func (*UnimplementedGameServer) PauseGame(context.Context, *PauseGameRequest) (*PauseGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseGame not implemented")
+}
+func (*UnimplementedGameServer) ResumeGame(context.Context, *ResumeGameRequest) (*ResumeGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeGame not implemented")
+}
 func (*UnimplementedGameServer) Credit(context.Context, *CreditRequest) (*CreditResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Credit not implemented")
 }
@@ -3093,15 +7104,30 @@ func (*UnimplementedGameServer) Deposit(context.Context, *DepositRequest) (*Depo
 func (*UnimplementedGameServer) Lottery(context.Context, *LotteryRequest) (*LotteryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Lottery not implemented")
 }
+func (*UnimplementedGameServer) LotteryCommitment(context.Context, *LotteryCommitmentRequest) (*LotteryCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LotteryCommitment not implemented")
+}
 func (*UnimplementedGameServer) GenerateQuestion(context.Context, *GenerateQuestionRequest) (*GenerateQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateQuestion not implemented")
 }
 func (*UnimplementedGameServer) AnswerQuestion(context.Context, *AnswerQuestionRequest) (*AnswerQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnswerQuestion not implemented")
 }
+func (*UnimplementedGameServer) GetGameState(context.Context, *GetGameStateRequest) (*GetGameStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameState not implemented")
+}
+func (*UnimplementedGameServer) GetGameSummary(context.Context, *GetGameSummaryRequest) (*GetGameSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameSummary not implemented")
+}
 func (*UnimplementedGameServer) Stream(*StreamRequest, Game_StreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
 }
+func (*UnimplementedGameServer) Resume(*ResumeRequest, Game_ResumeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (*UnimplementedGameServer) Spectate(*SpectateRequest, Game_SpectateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Spectate not implemented")
+}
 
 func RegisterGameServer(s *grpc.Server, srv GameServer) {
 	s.RegisterService(&_Game_serviceDesc, srv)
@@ -3143,6 +7169,114 @@ func _Game_Leave_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Game_CreateLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).CreateLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/CreateLobby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).CreateLobby(ctx, req.(*CreateLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ListLobbies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLobbiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ListLobbies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ListLobbies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ListLobbies(ctx, req.(*ListLobbiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Rematch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RematchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Rematch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Rematch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Rematch(ctx, req.(*RematchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ConfigureLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ConfigureLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ConfigureLobby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ConfigureLobby(ctx, req.(*ConfigureLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Kick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Kick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/Kick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Kick(ctx, req.(*KickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_LockLobby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockLobbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).LockLobby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/LockLobby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).LockLobby(ctx, req.(*LockLobbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Game_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartRequest)
 	if err := dec(in); err != nil {
@@ -3161,6 +7295,42 @@ func _Game_Start_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Game_PauseGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).PauseGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/PauseGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).PauseGame(ctx, req.(*PauseGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ResumeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ResumeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/ResumeGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ResumeGame(ctx, req.(*ResumeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Game_Credit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreditRequest)
 	if err := dec(in); err != nil {
@@ -3215,6 +7385,24 @@ func _Game_Lottery_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Game_LotteryCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LotteryCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).LotteryCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/LotteryCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).LotteryCommitment(ctx, req.(*LotteryCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Game_GenerateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateQuestionRequest)
 	if err := dec(in); err != nil {
@@ -3251,6 +7439,42 @@ func _Game_AnswerQuestion_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Game_GetGameState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetGameState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetGameState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetGameState(ctx, req.(*GetGameStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_GetGameSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).GetGameSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/server.Game/GetGameSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).GetGameSummary(ctx, req.(*GetGameSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Game_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3272,6 +7496,48 @@ func (x *gameStreamServer) Send(m *StreamResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Game_Resume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServer).Resume(m, &gameResumeServer{stream})
+}
+
+type Game_ResumeServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type gameResumeServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameResumeServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Game_Spectate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpectateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServer).Spectate(m, &gameSpectateServer{stream})
+}
+
+type Game_SpectateServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type gameSpectateServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameSpectateServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Game_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "server.Game",
 	HandlerType: (*GameServer)(nil),
@@ -3284,10 +7550,42 @@ var _Game_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Leave",
 			Handler:    _Game_Leave_Handler,
 		},
+		{
+			MethodName: "CreateLobby",
+			Handler:    _Game_CreateLobby_Handler,
+		},
+		{
+			MethodName: "ListLobbies",
+			Handler:    _Game_ListLobbies_Handler,
+		},
+		{
+			MethodName: "Rematch",
+			Handler:    _Game_Rematch_Handler,
+		},
+		{
+			MethodName: "ConfigureLobby",
+			Handler:    _Game_ConfigureLobby_Handler,
+		},
+		{
+			MethodName: "Kick",
+			Handler:    _Game_Kick_Handler,
+		},
+		{
+			MethodName: "LockLobby",
+			Handler:    _Game_LockLobby_Handler,
+		},
 		{
 			MethodName: "Start",
 			Handler:    _Game_Start_Handler,
 		},
+		{
+			MethodName: "PauseGame",
+			Handler:    _Game_PauseGame_Handler,
+		},
+		{
+			MethodName: "ResumeGame",
+			Handler:    _Game_ResumeGame_Handler,
+		},
 		{
 			MethodName: "Credit",
 			Handler:    _Game_Credit_Handler,
@@ -3300,6 +7598,10 @@ var _Game_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Lottery",
 			Handler:    _Game_Lottery_Handler,
 		},
+		{
+			MethodName: "LotteryCommitment",
+			Handler:    _Game_LotteryCommitment_Handler,
+		},
 		{
 			MethodName: "GenerateQuestion",
 			Handler:    _Game_GenerateQuestion_Handler,
@@ -3308,6 +7610,14 @@ var _Game_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnswerQuestion",
 			Handler:    _Game_AnswerQuestion_Handler,
 		},
+		{
+			MethodName: "GetGameState",
+			Handler:    _Game_GetGameState_Handler,
+		},
+		{
+			MethodName: "GetGameSummary",
+			Handler:    _Game_GetGameSummary_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3315,6 +7625,16 @@ var _Game_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Game_Stream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Resume",
+			Handler:       _Game_Resume_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Spectate",
+			Handler:       _Game_Spectate_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "game.proto",
 }
@@ -1,10 +1,9 @@
 package server
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
-	"net/http"
+	"math"
 	"time"
 
 	"github.com/cs489-team11/server/pb"
@@ -15,131 +14,290 @@ type questionID string
 type userID string
 type username string
 
+// numericAnswerTolerance is the percentage of the answer range.
+// Estimate for numeric question wins some points, only if it
+// differs from correct answer by less than this part of the range.
+const numericAnswerTolerance = 10
+
 type questionInfo struct {
+	questionType  string
+	text          string
+	answers       []string // empty for numeric questions
 	bidPoints     int32
-	correctAnswer int32 // index of correct answer from 1 to 4
+	winPercentage int32 // percentage of bid points won for correct answer
+	// index of correct answer from 1 to number of answers,
+	// or the exact number for numeric questions
+	correctAnswer int32
+	answerMin     int32
+	answerMax     int32
+	createdAt     time.Time
+	answerTime    time.Duration // time given to answer the question
+	// true after the deadline, the question is kept until
+	// the answer, so that the player is told it has expired
+	expired bool
+}
+
+// loan is an outstanding credit or deposit of the player.
+type loan struct {
+	value      int32 // value without interest
+	returnTime time.Time
 }
 
-// this struct does not have RWMutex as its member
-// this is done to avoid deadlocks between goroutines
+// this struct does not have RWMutex as its member,
+// since players are only accessed from the event loop of the game
 type player struct {
-	userID            userID
-	username          username
-	points            int32
-	stream            pb.Game_StreamServer
-	gameStartNotified bool
-	lastLotteryTime   time.Time
-	questions         map[questionID]*questionInfo
+	userID          userID
+	username        username
+	reconnectToken  string // secret, which allows to resume the game
+	points          int32
+	subscriber      *subscriber // nil, if the player hasn't opened the stream
+	lastLotteryTime time.Time
+	questions       map[questionID]*questionInfo
+	credits         []*loan // outstanding credits
+	deposits        []*loan // outstanding deposits
+	// board committed for the next lottery, nil if
+	// the player hasn't requested a commitment
+	lotteryCommitment *lotteryCommitment
+	statement         statement
+	clock             Clock // clock of the game
+}
+
+// statement contains totals of the player's
+// transactions, which are sent in the game summary.
+type statement struct {
+	creditsTaken          int32
+	creditInterestPaid    int32
+	depositsMade          int32
+	depositInterestEarned int32
+	theftLosses           int32
+	lotteryTicketsPaid    int32
+	lotteryWinnings       int32
+	questionBidsWon       int32 // points won above the bids
+	questionBidsLost      int32 // bids, which haven't been won back
 }
 
 func newQuestionInfo(
+	questionType string,
+	text string,
+	answers []string,
 	bidPoints int32,
+	winPercentage int32,
 	correctAnswer int32,
+	answerMin int32,
+	answerMax int32,
+	createdAt time.Time,
+	answerTime time.Duration,
 ) *questionInfo {
 	return &questionInfo{
-		bidPoints,
-		correctAnswer,
+		questionType:  questionType,
+		text:          text,
+		answers:       answers,
+		bidPoints:     bidPoints,
+		winPercentage: winPercentage,
+		correctAnswer: correctAnswer,
+		answerMin:     answerMin,
+		answerMax:     answerMax,
+		createdAt:     createdAt,
+		answerTime:    answerTime,
 	}
 }
 
-func newPlayer(username username, points int32) *player {
+// winFraction returns the part of the full win, which player
+// gets for the answer. For questions with answer choices, it is
+// either 0 or 1. For numeric questions, it decreases linearly
+// with the distance between the estimate and the correct answer.
+func (q *questionInfo) winFraction(userAnswer int32) float64 {
+	if q.questionType != numericQuestionType {
+		if userAnswer == q.correctAnswer {
+			return 1
+		}
+		return 0
+	}
+
+	tolerance := float64(q.answerMax-q.answerMin) * numericAnswerTolerance / 100.0
+	distance := math.Abs(float64(userAnswer - q.correctAnswer))
+	if distance >= tolerance {
+		return 0
+	}
+	return 1 - distance/tolerance
+}
+
+func (q *questionInfo) isExpired(now time.Time) bool {
+	return now.Sub(q.createdAt) > q.answerTime
+}
+
+func newPlayer(username username, points int32, clock Clock) *player {
 	userID := userID(uuid.New().String())
 	return &player{
-		userID:            userID,
-		username:          username,
-		points:            points,
-		stream:            nil,
-		gameStartNotified: false,
-		lastLotteryTime:   time.Now(),
-		questions:         make(map[questionID]*questionInfo),
+		userID:          userID,
+		username:        username,
+		reconnectToken:  uuid.New().String(),
+		points:          points,
+		subscriber:      nil,
+		lastLotteryTime: clock.Now(),
+		questions:       make(map[questionID]*questionInfo),
+		clock:           clock,
 	}
 }
 
-// when game calls this function on player, make sure to grab
-// WRITE lock on game
-func (p *player) setStream(stream pb.Game_StreamServer) {
-	p.stream = stream
+// setSubscriber replaces the stream of the player.
+// The previous stream is disconnected, if there is one.
+// NOTE: has to be called in the event loop of the game.
+func (p *player) setSubscriber(subscriber *subscriber) {
+	if p.subscriber != nil {
+		p.subscriber.disconnect("stream has been replaced by a new one")
+	}
+	p.subscriber = subscriber
 	log.Printf("Stream for user %v has been set.\n", p.userID)
 }
 
-// when game calls this function on player, make sure to grab
-// WRITE lock on game
+// NOTE: has to be called in the event loop of the game.
 func (p *player) updateLastLotteryTime() {
-	p.lastLotteryTime = time.Now()
+	p.lastLotteryTime = p.clock.Now()
 }
 
-// when game calls this function on player, make sure to grab
-// READ lock on game
+// NOTE: has to be called in the event loop of the game.
 // "lotteryTime" is the time in seconds from game config,
 // which has to pass before player can play lottery again
 func (p *player) canPlayLottery(lotteryTime int32) bool {
-	return time.Since(p.lastLotteryTime) >= (time.Duration(lotteryTime) * time.Second / time.Nanosecond)
+	return p.clock.Now().Sub(p.lastLotteryTime) >= (time.Duration(lotteryTime) * time.Second / time.Nanosecond)
 }
 
-func (p *player) generateQuestion(bidPoints int32) (questionID, string, []string, error) {
+// "answerTime" is the time in seconds from game config,
+// which player has to answer the question.
+// "winPercentage" is the percentage of bid points, which
+// player wins for the correct answer.
+// "random" is used to place the correct answer among the others.
+func (p *player) generateQuestion(
+	bidPoints int32, question *Question, answerTime int32, winPercentage int32, random RandomSource,
+) (questionID, []string, error) {
 	if bidPoints > p.points {
-		return "", "", nil, fmt.Errorf(
+		return "", nil, fmt.Errorf(
 			"bid points (%d) has to be less than or equal to player's points (%d)",
 			bidPoints,
 			p.points,
 		)
 	}
 
-	resp, err := http.Get("https://opentdb.com/api.php?amount=1&difficulty=easy&type=multiple&encode=base64")
-	if err != nil {
-		return "", "", nil, fmt.Errorf("HTTP request failed: %v", err)
+	var allAnswers []string
+	var correctAnswer int32
+	if question.Type == numericQuestionType {
+		correctAnswer = question.NumericAnswer
+	} else {
+		incorrectAnswers := make([]string, len(question.IncorrectAnswers))
+		copy(incorrectAnswers, question.IncorrectAnswers)
+		correctAnswerIndex := random.Intn(len(incorrectAnswers) + 1) // from 0 to the number of incorrect answers
+		allAnswers = insertToSlice(incorrectAnswers, correctAnswerIndex, question.CorrectAnswer)
+		correctAnswer = int32(correctAnswerIndex + 1)
 	}
-	defer resp.Body.Close()
 
-	/*
-		Sample API response body:
-			map[response_code:0 results:[map[category:Entertainment: Musicals & Theatres correct_answer:Et tu, Brute?  difficulty:easy
-			incorrect_answers:[Iacta alea est! Vidi, vini, vici. Aegri somnia vana.] question:In Shakespeare&#039;s play Julius Caesa
-			r, Caesar&#039;s last words were... type:multiple]]]
-	*/
+	answerMin, answerMax := question.answerRange()
+	questionID := questionID(uuid.New().String())
+	qInfo := newQuestionInfo(
+		question.Type,
+		question.Text,
+		allAnswers,
+		bidPoints,
+		winPercentage,
+		correctAnswer,
+		answerMin,
+		answerMax,
+		p.clock.Now(),
+		time.Duration(answerTime)*time.Second,
+	)
+	p.questions[questionID] = qInfo
+
+	return questionID, allAnswers, nil
+}
 
-	var data map[string]interface{}
-	err = json.NewDecoder(resp.Body).Decode(&data)
-	if err != nil {
-		return "", "", nil, fmt.Errorf("response decoding failure: %v", err)
+// answerRange returns minimum and maximum answer to the question.
+func (p *player) answerRange(questionID questionID) (int32, int32, error) {
+	qInfo, ok := p.questions[questionID]
+	if !ok {
+		return 0, 0, fmt.Errorf("there is no question %v for player %v", questionID, p.userID)
 	}
-	//log.Printf("Question response data:\n%v\n", results_[0])
+	return qInfo.answerMin, qInfo.answerMax, nil
+}
 
-	// parse API response body
-	results := data["results"].([]interface{})[0].(map[string]interface{})
-	question := decodeB64(results["question"].(string))
-	correctAnswer := decodeB64(results["correct_answer"].(string))
-	incorrectAnswers := make([]string, 3)
-	for i := 0; i < 3; i++ {
-		incorrectAnswers[i] = decodeB64(results["incorrect_answers"].([]interface{})[i].(string))
+// Each question can be answered only once, so it is removed after
+// the answer. If the question has expired, "expired" is true and the
+// answer mustn't be accepted.
+func (p *player) answerQuestion(questionID questionID) (*questionInfo, bool, error) {
+	qInfo, ok := p.questions[questionID]
+	if !ok {
+		errMsg := fmt.Sprintf("there is no question %v for player %v", questionID, p.userID)
+		return nil, false, fmt.Errorf(errMsg)
 	}
+	delete(p.questions, questionID)
 
-	correctAnswerIndex := seededRand.Intn(4) // 0,1,2, or 3
-	allAnswers := insertToSlice(incorrectAnswers, correctAnswerIndex, correctAnswer)
+	return qInfo, qInfo.expired || qInfo.isExpired(p.clock.Now()), nil
+}
 
-	questionID := questionID(uuid.New().String())
-	qInfo := newQuestionInfo(bidPoints, int32(correctAnswerIndex+1))
-	p.questions[questionID] = qInfo
+// expireQuestion marks the question as expired, if it is still
+// not answered. It returns bid points of the question and "true",
+// if the question has been marked.
+func (p *player) expireQuestion(questionID questionID) (int32, bool) {
+	qInfo, ok := p.questions[questionID]
+	if !ok || qInfo.expired {
+		return 0, false
+	}
+	qInfo.expired = true
+	return qInfo.bidPoints, true
+}
 
-	return questionID, question, allAnswers, nil
+// shiftTimes moves times of the player forward by d,
+// e.g. after the game has been paused for d.
+func (p *player) shiftTimes(d time.Duration) {
+	p.lastLotteryTime = p.lastLotteryTime.Add(d)
+	for _, qInfo := range p.questions {
+		qInfo.createdAt = qInfo.createdAt.Add(d)
+	}
+	for _, credit := range p.credits {
+		credit.returnTime = credit.returnTime.Add(d)
+	}
+	for _, deposit := range p.deposits {
+		deposit.returnTime = deposit.returnTime.Add(d)
+	}
 }
 
-func (p *player) answerQuestion(
-	questionID questionID, userAnswer int32,
-) (
-	bool, int32, int32, error,
-) {
-	qInfo, ok := p.questions[questionID]
-	if !ok {
-		errMsg := fmt.Sprintf("there is no question %v for player %v", questionID, p.userID)
-		return false, 0, 0, fmt.Errorf(errMsg)
+// removeLoan removes the loan from loans, if it is there.
+func removeLoan(loans []*loan, l *loan) []*loan {
+	for i, other := range loans {
+		if other == l {
+			return append(loans[:i], loans[i+1:]...)
+		}
 	}
+	return loans
+}
 
-	return qInfo.correctAnswer == userAnswer, qInfo.correctAnswer, qInfo.bidPoints, nil
+// NOTE: has to be called in the event loop of the game.
+// Bids of questions, which haven't been answered yet, are lost.
+// Bids of expired questions are already in the statement.
+func (p *player) toPBPlayerSummary(rank int32) *pb.GameSummary_PlayerSummary {
+	questionBidsLost := p.statement.questionBidsLost
+	for _, qInfo := range p.questions {
+		if !qInfo.expired {
+			questionBidsLost += qInfo.bidPoints
+		}
+	}
+	return &pb.GameSummary_PlayerSummary{
+		UserId:                string(p.userID),
+		Username:              string(p.username),
+		Points:                p.points,
+		Rank:                  rank,
+		CreditsTaken:          p.statement.creditsTaken,
+		CreditInterestPaid:    p.statement.creditInterestPaid,
+		DepositsMade:          p.statement.depositsMade,
+		DepositInterestEarned: p.statement.depositInterestEarned,
+		TheftLosses:           p.statement.theftLosses,
+		LotteryTicketsPaid:    p.statement.lotteryTicketsPaid,
+		LotteryWinnings:       p.statement.lotteryWinnings,
+		QuestionBidsWon:       p.statement.questionBidsWon,
+		QuestionBidsLost:      questionBidsLost,
+	}
 }
 
-// when game calls this function on player, make sure to grab
-// READ lock on game
+// NOTE: has to be called in the event loop of the game.
 func (p *player) toPBPlayer() *pb.Player {
 	return &pb.Player{
 		UserId:   string(p.userID),
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Question is a single question, which is used
+// for the question bidding part of the game.
+// For numeric questions, there are no answers to choose from.
+// Instead, the player has to estimate NumericAnswer, which is
+// between AnswerMin and AnswerMax.
+type Question struct {
+	Type             string
+	Text             string
+	CorrectAnswer    string
+	IncorrectAnswers []string
+	NumericAnswer    int32
+	AnswerMin        int32
+	AnswerMax        int32
+	Category         string
+	Difficulty       string
+}
+
+// answerRange returns the minimum and maximum answer,
+// which player can give to the question.
+func (q *Question) answerRange() (int32, int32) {
+	if q.Type == numericQuestionType {
+		return q.AnswerMin, q.AnswerMax
+	}
+	return 1, int32(len(q.IncorrectAnswers) + 1)
+}
+
+// Question types, which can be requested by players.
+const (
+	multipleQuestionType = "multiple"
+	booleanQuestionType  = "boolean"
+	numericQuestionType  = "numeric"
+)
+
+// QuestionTypes lists all question types.
+var QuestionTypes = []string{multipleQuestionType, booleanQuestionType, numericQuestionType}
+
+// Question difficulties, which can be requested by players.
+const (
+	easyDifficulty   = "easy"
+	mediumDifficulty = "medium"
+	hardDifficulty   = "hard"
+)
+
+// QuestionDifficulties lists all question difficulties.
+var QuestionDifficulties = []string{easyDifficulty, mediumDifficulty, hardDifficulty}
+
+// QuestionFilter describes which question is requested
+// from the question provider. Empty fields match
+// questions of any type, difficulty or category.
+type QuestionFilter struct {
+	Type       string
+	Difficulty string
+	Category   string
+}
+
+// QuestionProvider is a source of questions for the game.
+// Implementations have to be safe for concurrent use, since
+// questions are requested from different games at the same time.
+type QuestionProvider interface {
+	GetQuestion(filter QuestionFilter) (*Question, error)
+	// Categories returns the categories, which can be used in filter.
+	Categories() []string
+}
+
+// openTDBCategories maps category names of the
+// Open Trivia Database to their ids in the API.
+var openTDBCategories = map[string]int{
+	"General Knowledge":                     9,
+	"Entertainment: Books":                  10,
+	"Entertainment: Film":                   11,
+	"Entertainment: Music":                  12,
+	"Entertainment: Musicals & Theatres":    13,
+	"Entertainment: Television":             14,
+	"Entertainment: Video Games":            15,
+	"Entertainment: Board Games":            16,
+	"Science & Nature":                      17,
+	"Science: Computers":                    18,
+	"Science: Mathematics":                  19,
+	"Mythology":                             20,
+	"Sports":                                21,
+	"Geography":                             22,
+	"History":                               23,
+	"Politics":                              24,
+	"Art":                                   25,
+	"Celebrities":                           26,
+	"Animals":                               27,
+	"Vehicles":                              28,
+	"Entertainment: Comics":                 29,
+	"Science: Gadgets":                      30,
+	"Entertainment: Japanese Anime & Manga": 31,
+	"Entertainment: Cartoon & Animations":   32,
+}
+
+// OpenTDBProvider fetches questions from the Open Trivia Database.
+// It requires internet access. Numeric questions are not supported.
+type OpenTDBProvider struct {
+	url    string
+	client *http.Client
+}
+
+// time limit of a single request to opentdb.com
+const openTDBTimeout = 10 * time.Second
+
+// NewOpenTDBProvider returns a provider, which requests
+// questions from opentdb.com.
+func NewOpenTDBProvider() *OpenTDBProvider {
+	return &OpenTDBProvider{
+		url:    "https://opentdb.com/api.php",
+		client: &http.Client{Timeout: openTDBTimeout},
+	}
+}
+
+// Categories returns names of all Open Trivia Database categories.
+func (p *OpenTDBProvider) Categories() []string {
+	categories := make([]string, 0, len(openTDBCategories))
+	for category := range openTDBCategories {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// GetQuestion requests a single question from opentdb.com.
+func (p *OpenTDBProvider) GetQuestion(filter QuestionFilter) (*Question, error) {
+	questionType := filter.Type
+	if questionType == "" {
+		questionType = multipleQuestionType
+	}
+	if questionType == numericQuestionType {
+		return nil, fmt.Errorf("opentdb doesn't provide numeric questions")
+	}
+
+	query := url.Values{}
+	query.Set("amount", "1")
+	query.Set("type", questionType)
+	query.Set("encode", "base64")
+	if filter.Difficulty != "" {
+		query.Set("difficulty", filter.Difficulty)
+	}
+	if filter.Category != "" {
+		categoryID, ok := openTDBCategories[filter.Category]
+		if !ok {
+			return nil, fmt.Errorf("unknown category %q", filter.Category)
+		}
+		query.Set("category", strconv.Itoa(categoryID))
+	}
+
+	resp, err := p.client.Get(p.url + "?" + query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	/*
+		Sample API response body:
+			map[response_code:0 results:[map[category:Entertainment: Musicals & Theatres correct_answer:Et tu, Brute?  difficulty:easy
+			incorrect_answers:[Iacta alea est! Vidi, vini, vici. Aegri somnia vana.] question:In Shakespeare&#039;s play Julius Caesa
+			r, Caesar&#039;s last words were... type:multiple]]]
+	*/
+
+	var data map[string]interface{}
+	err = json.NewDecoder(resp.Body).Decode(&data)
+	if err != nil {
+		return nil, fmt.Errorf("response decoding failure: %v", err)
+	}
+
+	// parse API response body
+	responseCode, ok := data["response_code"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("opentdb returned no response code: %v", data)
+	}
+	if responseCode != 0 {
+		return nil, fmt.Errorf("opentdb returned response code %v", responseCode)
+	}
+	resultList, ok := data["results"].([]interface{})
+	if !ok || len(resultList) == 0 {
+		return nil, fmt.Errorf("opentdb returned no questions: %v", data)
+	}
+	results, ok := resultList[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("opentdb returned malformed question: %v", resultList[0])
+	}
+	answerList, ok := results["incorrect_answers"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("opentdb returned question without incorrect answers: %v", results)
+	}
+	var incorrectAnswers []string
+	for _, answer := range answerList {
+		encoded, ok := answer.(string)
+		if !ok {
+			return nil, fmt.Errorf("opentdb returned malformed incorrect answer: %v", answer)
+		}
+		incorrectAnswers = append(incorrectAnswers, decodeB64(encoded))
+	}
+
+	question := &Question{
+		Type:             questionType,
+		IncorrectAnswers: incorrectAnswers,
+	}
+	fields := []struct {
+		key   string
+		value *string
+	}{
+		{"question", &question.Text},
+		{"correct_answer", &question.CorrectAnswer},
+		{"category", &question.Category},
+		{"difficulty", &question.Difficulty},
+	}
+	for _, field := range fields {
+		encoded, ok := results[field.key].(string)
+		if !ok {
+			return nil, fmt.Errorf("opentdb returned question without %v: %v", field.key, results)
+		}
+		*field.value = decodeB64(encoded)
+	}
+	return question, nil
+}
+
+// LocalQuestionProvider serves questions from a question bank,
+// which is loaded into memory once. It doesn't need internet access.
+type LocalQuestionProvider struct {
+	questions  []*Question
+	categories []string
+}
+
+// NewLocalQuestionProvider loads question banks from the files
+// located at paths. See LoadQuestionBank for supported formats.
+func NewLocalQuestionProvider(paths ...string) (*LocalQuestionProvider, error) {
+	var questions []*Question
+	for _, path := range paths {
+		bank, err := LoadQuestionBank(path)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, bank...)
+	}
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("question bank %v is empty", paths)
+	}
+
+	categorySet := make(map[string]bool)
+	var categories []string
+	for _, question := range questions {
+		if !categorySet[question.Category] {
+			categorySet[question.Category] = true
+			categories = append(categories, question.Category)
+		}
+	}
+	sort.Strings(categories)
+
+	return &LocalQuestionProvider{
+		questions:  questions,
+		categories: categories,
+	}, nil
+}
+
+// Categories returns categories of the questions in the question bank.
+func (p *LocalQuestionProvider) Categories() []string {
+	return p.categories
+}
+
+// GetQuestion returns a random question from the question bank,
+// which matches the filter.
+func (p *LocalQuestionProvider) GetQuestion(filter QuestionFilter) (*Question, error) {
+	var candidates []*Question
+	for _, question := range p.questions {
+		if filter.Type != "" && question.Type != filter.Type {
+			continue
+		}
+		if filter.Difficulty != "" && question.Difficulty != filter.Difficulty {
+			continue
+		}
+		if filter.Category != "" && question.Category != filter.Category {
+			continue
+		}
+		candidates = append(candidates, question)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf(
+			"question bank has no %s %s questions in category %q",
+			filter.Difficulty,
+			filter.Type,
+			filter.Category,
+		)
+	}
+	return candidates[seededRand.Intn(len(candidates))], nil
+}
@@ -0,0 +1,369 @@
+package server
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// questionRecord is the format of a single question
+// in a question bank file.
+type questionRecord struct {
+	Type     string   `json:"type" yaml:"type"` // "multiple" if empty
+	Question string   `json:"question" yaml:"question"`
+	Answers  []string `json:"answers" yaml:"answers"`
+	// index of correct answer from 1 to number of answers,
+	// or the exact number for numeric questions
+	CorrectAnswer int32  `json:"correct_answer" yaml:"correct_answer"`
+	AnswerMin     int32  `json:"answer_min" yaml:"answer_min"` // only for numeric questions
+	AnswerMax     int32  `json:"answer_max" yaml:"answer_max"` // only for numeric questions
+	Category      string `json:"category" yaml:"category"`
+	Difficulty    string `json:"difficulty" yaml:"difficulty"`
+
+	line int // line in the source file, where the record starts
+}
+
+// csvHeader lists the columns, which question bank
+// in CSV format has to contain in its first line.
+// The last columns can be omitted, if the bank
+// contains only multiple-choice questions.
+var csvHeader = []string{
+	"question", "answer1", "answer2", "answer3", "answer4", "correct_answer", "category", "difficulty",
+	"type", "answer_min", "answer_max",
+}
+
+// number of columns in CSV question bank
+// with only multiple-choice questions
+const csvMultipleHeaderLen = 8
+
+// answerCounts is the number of answers for
+// each type of question with answer choices.
+var answerCounts = map[string]int{
+	multipleQuestionType: 4,
+	booleanQuestionType:  2,
+}
+
+// validate checks that the question can be used in the game.
+func (r *questionRecord) validate() error {
+	if r.Type == "" {
+		r.Type = multipleQuestionType
+	}
+	if !containsString(QuestionTypes, r.Type) {
+		return fmt.Errorf("type has to be one of %v, got %q", QuestionTypes, r.Type)
+	}
+	if strings.TrimSpace(r.Question) == "" {
+		return fmt.Errorf("question text is empty")
+	}
+
+	if r.Type == numericQuestionType {
+		if len(r.Answers) != 0 {
+			return fmt.Errorf("numeric question cannot have answers, got %d", len(r.Answers))
+		}
+		if r.AnswerMin >= r.AnswerMax {
+			return fmt.Errorf(
+				"answer min (%d) has to be less than answer max (%d)", r.AnswerMin, r.AnswerMax,
+			)
+		}
+		if r.CorrectAnswer < r.AnswerMin || r.CorrectAnswer > r.AnswerMax {
+			return fmt.Errorf(
+				"correct answer has to be from %d to %d, got %d", r.AnswerMin, r.AnswerMax, r.CorrectAnswer,
+			)
+		}
+	} else {
+		answerCount := answerCounts[r.Type]
+		if len(r.Answers) != answerCount {
+			return fmt.Errorf(
+				"%s question has to have exactly %d answers, got %d", r.Type, answerCount, len(r.Answers),
+			)
+		}
+		seen := make(map[string]bool)
+		for i, answer := range r.Answers {
+			answer = strings.TrimSpace(answer)
+			if answer == "" {
+				return fmt.Errorf("answer %d is empty", i+1)
+			}
+			if seen[answer] {
+				return fmt.Errorf("answer %q is repeated", answer)
+			}
+			seen[answer] = true
+		}
+		if r.CorrectAnswer < 1 || int(r.CorrectAnswer) > answerCount {
+			return fmt.Errorf(
+				"correct answer has to be an index from 1 to %d, got %d", answerCount, r.CorrectAnswer,
+			)
+		}
+	}
+
+	if strings.TrimSpace(r.Category) == "" {
+		return fmt.Errorf("category is empty")
+	}
+	if !containsString(QuestionDifficulties, r.Difficulty) {
+		return fmt.Errorf("difficulty has to be one of %v, got %q", QuestionDifficulties, r.Difficulty)
+	}
+	return nil
+}
+
+// NOTE: record has to be validated before the call.
+func (r *questionRecord) toQuestion() *Question {
+	if r.Type == numericQuestionType {
+		return &Question{
+			Type:          r.Type,
+			Text:          r.Question,
+			NumericAnswer: r.CorrectAnswer,
+			AnswerMin:     r.AnswerMin,
+			AnswerMax:     r.AnswerMax,
+			Category:      r.Category,
+			Difficulty:    r.Difficulty,
+		}
+	}
+
+	var incorrectAnswers []string
+	for i, answer := range r.Answers {
+		if int32(i+1) != r.CorrectAnswer {
+			incorrectAnswers = append(incorrectAnswers, answer)
+		}
+	}
+	return &Question{
+		Type:             r.Type,
+		Text:             r.Question,
+		CorrectAnswer:    r.Answers[r.CorrectAnswer-1],
+		IncorrectAnswers: incorrectAnswers,
+		Category:         r.Category,
+		Difficulty:       r.Difficulty,
+	}
+}
+
+// LoadQuestionBank reads and validates questions from the file
+// located at path. The format is chosen by file extension:
+// ".json" (array of objects), ".yaml"/".yml" (sequence of mappings)
+// or ".csv" (one question per line with a header line).
+// All invalid questions are reported in the returned error
+// together with line numbers.
+func LoadQuestionBank(path string) ([]*Question, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read question bank: %v", err)
+	}
+
+	var records []*questionRecord
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		records, err = parseJSONQuestionRecords(content)
+	case ".yaml", ".yml":
+		records, err = parseYAMLQuestionRecords(content)
+	case ".csv":
+		records, err = parseCSVQuestionRecords(content)
+	default:
+		err = fmt.Errorf("unsupported question bank format %q", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	var errMsgs []string
+	questions := make([]*Question, 0, len(records))
+	for _, record := range records {
+		if err := record.validate(); err != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s:%d: %v", path, record.line, err))
+			continue
+		}
+		questions = append(questions, record.toQuestion())
+	}
+	if len(errMsgs) > 0 {
+		return nil, fmt.Errorf("invalid questions in question bank:\n%s", strings.Join(errMsgs, "\n"))
+	}
+	return questions, nil
+}
+
+// lineAt returns the line number (starting from 1) of the first
+// character at or after offset, which is not a whitespace or comma.
+func lineAt(content []byte, offset int64) int {
+	for offset < int64(len(content)) && strings.ContainsRune(" \t\r\n,", rune(content[offset])) {
+		offset++
+	}
+	return bytes.Count(content[:offset], []byte("\n")) + 1
+}
+
+func parseJSONQuestionRecords(content []byte) ([]*questionRecord, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("%d: expected array of questions", lineAt(content, 0))
+	}
+
+	var records []*questionRecord
+	for decoder.More() {
+		line := lineAt(content, decoder.InputOffset())
+		record := &questionRecord{line: line}
+		if err := decoder.Decode(record); err != nil {
+			return nil, fmt.Errorf("%d: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func parseYAMLQuestionRecords(content []byte) ([]*questionRecord, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, err
+	}
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+
+	sequence := root.Content[0]
+	if sequence.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("%d: expected sequence of questions", sequence.Line)
+	}
+
+	var records []*questionRecord
+	for _, node := range sequence.Content {
+		record := &questionRecord{line: node.Line}
+		if err := node.Decode(record); err != nil {
+			return nil, fmt.Errorf("%d: %v", node.Line, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// lineReader returns at most one line per Read, so that the
+// number of lines read by csv.Reader is known after each record.
+type lineReader struct {
+	content []byte
+	offset  int
+	lines   int // number of lines, which have been read completely
+}
+
+func (r *lineReader) Read(p []byte) (int, error) {
+	if r.offset == len(r.content) {
+		return 0, io.EOF
+	}
+	end := bytes.IndexByte(r.content[r.offset:], '\n') + 1
+	if end == 0 {
+		end = len(r.content) - r.offset
+	}
+	n := copy(p, r.content[r.offset:r.offset+end])
+	r.offset += n
+	if r.offset == len(r.content) || r.content[r.offset-1] == '\n' {
+		r.lines++
+	}
+	return n, nil
+}
+
+// Quoted fields can contain line breaks. Answer columns, which
+// are not used by the type of the question, have to be empty.
+func parseCSVQuestionRecords(content []byte) ([]*questionRecord, error) {
+	lines := &lineReader{content: content}
+	reader := csv.NewReader(lines)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, csvError(err)
+	}
+	if strings.Join(header, ",") == strings.Join(csvHeader, ",") {
+		reader.FieldsPerRecord = len(csvHeader)
+	} else if strings.Join(header, ",") == strings.Join(csvHeader[:csvMultipleHeaderLen], ",") {
+		reader.FieldsPerRecord = csvMultipleHeaderLen
+	} else {
+		return nil, fmt.Errorf("%d: header has to be %q", lines.lines, strings.Join(csvHeader, ","))
+	}
+
+	var records []*questionRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if parseErr, ok := err.(*csv.ParseError); ok && parseErr.Err == csv.ErrFieldCount {
+			return nil, fmt.Errorf(
+				"%d: expected %d fields, got %d", parseErr.StartLine, reader.FieldsPerRecord, len(fields),
+			)
+		}
+		if err != nil {
+			return nil, csvError(err)
+		}
+		// the record ends at the last read line
+		lineNumber := lines.lines - strings.Count(strings.Join(fields, ""), "\n")
+
+		// filling optional columns, so that all
+		// lines can be parsed in the same way
+		for len(fields) < len(csvHeader) {
+			fields = append(fields, "")
+		}
+
+		var numbers [3]int32
+		for j, column := range []int{5, 9, 10} {
+			if fields[column] == "" {
+				continue
+			}
+			number, err := strconv.Atoi(fields[column])
+			if err != nil {
+				return nil, fmt.Errorf(
+					"%d: %s %q is not an integer", lineNumber, csvHeader[column], fields[column],
+				)
+			}
+			numbers[j] = int32(number)
+		}
+
+		questionType := fields[8]
+		if questionType == "" {
+			questionType = multipleQuestionType
+		}
+		answers := fields[1:5]
+		if answerCount, ok := answerCounts[questionType]; ok || questionType == numericQuestionType {
+			answers = csvAnswers(answers, answerCount)
+		}
+
+		records = append(records, &questionRecord{
+			Type:          fields[8],
+			Question:      fields[0],
+			Answers:       answers,
+			CorrectAnswer: numbers[0],
+			AnswerMin:     numbers[1],
+			AnswerMax:     numbers[2],
+			Category:      fields[6],
+			Difficulty:    fields[7],
+			line:          lineNumber,
+		})
+	}
+}
+
+// csvAnswers returns the first answerCount answer columns. If any of
+// the other columns is not empty, all of them are returned, so that
+// the wrong number of answers is reported by the validation.
+func csvAnswers(columns []string, answerCount int) []string {
+	for _, column := range columns[answerCount:] {
+		if column != "" {
+			return columns
+		}
+	}
+	return columns[:answerCount]
+}
+
+// csvError adds the line number to the error of csv.Reader.
+func csvError(err error) error {
+	parseErr, ok := err.(*csv.ParseError)
+	if !ok {
+		return err
+	}
+	return fmt.Errorf("%d: %v", parseErr.StartLine, parseErr.Err)
+}
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// number of ready questions, which are kept by the server
+	// for each requested question filter
+	questionCacheSize = 10
+	// number of questions fetched directly from provider, when
+	// the cache has no suitable question, before giving up on
+	// finding a question, which hasn't been served in the game yet
+	maxQuestionFetchAttempts = 3
+	// time to wait before retrying, if the provider fails,
+	// which is doubled after each consecutive failure
+	questionFetchRetryDelay = 5 * time.Second
+	// number of consecutive failures, after which
+	// the buffer of the filter is dropped
+	maxQuestionFetchFailures = 5
+	// buffer of the filter is dropped, if no
+	// question has been requested for this time
+	questionFilterIdleTime = 10 * time.Minute
+)
+
+// questionBuffer contains questions prefetched for a single filter.
+type questionBuffer struct {
+	questions     []*Question
+	failures      int       // consecutive failed fetches
+	retryAt       time.Time // the buffer isn't refilled before it after a failure
+	lastRequested time.Time
+}
+
+// questionCache keeps bounded buffers of questions fetched in
+// advance from a question provider, so that games don't have to
+// wait for network requests while generating questions.
+// There is a separate buffer for each question filter, which
+// has been requested recently. The buffers are refilled
+// asynchronously in a separate goroutine.
+type questionCache struct {
+	mutex    sync.Mutex
+	provider QuestionProvider
+	capacity int
+	buffers  map[QuestionFilter]*questionBuffer
+	refill   chan struct{}
+}
+
+// newQuestionCache creates a question cache and launches the
+// goroutine filling it with questions. Easy multiple-choice
+// questions of any category, which are requested by default,
+// are prefetched right away.
+func newQuestionCache(provider QuestionProvider, capacity int) *questionCache {
+	c := &questionCache{
+		provider: provider,
+		capacity: capacity,
+		buffers:  make(map[QuestionFilter]*questionBuffer),
+		refill:   make(chan struct{}, 1),
+	}
+	c.buffers[QuestionFilter{Type: multipleQuestionType, Difficulty: easyDifficulty}] = &questionBuffer{
+		lastRequested: time.Now(),
+	}
+	go c.run()
+	c.requestRefill()
+	return c
+}
+
+// categories returns categories of the underlying provider.
+func (c *questionCache) categories() []string {
+	return c.provider.Categories()
+}
+
+// requestRefill wakes up the refilling goroutine without blocking.
+func (c *questionCache) requestRefill() {
+	select {
+	case c.refill <- struct{}{}:
+	default:
+	}
+}
+
+// nextFilterToRefill returns a filter, whose buffer is not full and
+// can be refilled now. Second returned value is false, if there is
+// no such filter. Buffers, which haven't been requested for
+// questionFilterIdleTime, are dropped.
+func (c *questionCache) nextFilterToRefill() (QuestionFilter, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := time.Now()
+	for filter, buffer := range c.buffers {
+		if now.Sub(buffer.lastRequested) > questionFilterIdleTime {
+			delete(c.buffers, filter)
+			continue
+		}
+		if len(buffer.questions) < c.capacity && !now.Before(buffer.retryAt) {
+			return filter, true
+		}
+	}
+	return QuestionFilter{}, false
+}
+
+// run fetches questions until all buffers are full and then
+// waits until some question is taken from the cache. The filter,
+// for which the provider fails, is retried later with exponential
+// backoff, so that other filters are refilled meanwhile.
+func (c *questionCache) run() {
+	for range c.refill {
+		for {
+			filter, ok := c.nextFilterToRefill()
+			if !ok {
+				break
+			}
+
+			question, err := c.provider.GetQuestion(filter)
+			if err != nil {
+				log.Printf("Question cache failed to prefetch question for %+v: %v\n", filter, err)
+				c.addFailure(filter)
+				continue
+			}
+			c.add(filter, question)
+		}
+	}
+}
+
+// add appends the prefetched question to the buffer of the
+// filter, unless the buffer has been dropped meanwhile.
+func (c *questionCache) add(filter QuestionFilter, question *Question) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	buffer, ok := c.buffers[filter]
+	if !ok {
+		return
+	}
+	buffer.questions = append(buffer.questions, question)
+	buffer.failures = 0
+}
+
+// addFailure postpones the refill of the filter after the failed
+// fetch. The buffer is dropped after maxQuestionFetchFailures
+// consecutive failures.
+func (c *questionCache) addFailure(filter QuestionFilter) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	buffer, ok := c.buffers[filter]
+	if !ok {
+		return
+	}
+	buffer.failures++
+	if buffer.failures >= maxQuestionFetchFailures {
+		log.Printf("Question cache stopped prefetching questions for %+v\n", filter)
+		delete(c.buffers, filter)
+		return
+	}
+	delay := questionFetchRetryDelay << uint(buffer.failures-1)
+	buffer.retryAt = time.Now().Add(delay)
+	time.AfterFunc(delay, c.requestRefill)
+}
+
+// take returns a question matching the filter, for which isServed
+// returns false. Buffered questions are preferred. If there is no
+// suitable question in the buffer, the question is fetched directly
+// from the provider. If all fetched questions have been served,
+// the last one is returned. If the provider is failing for the
+// filter, an error is returned without fetching the question.
+// The cache isn't locked while isServed is called.
+func (c *questionCache) take(filter QuestionFilter, isServed func(*Question) bool) (*Question, error) {
+	defer c.requestRefill()
+
+	c.mutex.Lock()
+	var candidates []*Question
+	failing := false
+	if buffer, ok := c.buffers[filter]; ok {
+		buffer.lastRequested = time.Now()
+		candidates = append(candidates, buffer.questions...)
+		failing = buffer.failures > 0
+	}
+	c.mutex.Unlock()
+	for _, question := range candidates {
+		// the question may have been taken by another game meanwhile
+		if !isServed(question) && c.remove(filter, question) {
+			return question, nil
+		}
+	}
+	if failing {
+		return nil, fmt.Errorf("questions for %+v are unavailable, please try again later", filter)
+	}
+
+	var question *Question
+	var err error
+	for i := 0; i < maxQuestionFetchAttempts; i++ {
+		question, err = c.provider.GetQuestion(filter)
+		if err != nil {
+			return nil, err
+		}
+		if !isServed(question) {
+			break
+		}
+	}
+
+	// the provider has questions for the filter, so
+	// the buffer for the filter is created to be refilled
+	c.mutex.Lock()
+	if _, ok := c.buffers[filter]; !ok {
+		c.buffers[filter] = &questionBuffer{lastRequested: time.Now()}
+	}
+	c.mutex.Unlock()
+
+	return question, nil
+}
+
+// remove deletes the question from the buffer of the filter.
+// It returns false, if the question is not in the buffer.
+func (c *questionCache) remove(filter QuestionFilter, question *Question) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	buffer, ok := c.buffers[filter]
+	if !ok {
+		return false
+	}
+	for i, other := range buffer.questions {
+		if other == question {
+			buffer.questions = append(buffer.questions[:i], buffer.questions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
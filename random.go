@@ -4,6 +4,7 @@ package server
 import (
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 )
 
@@ -17,9 +18,51 @@ var names = []string{
 	"Nicole", "Adam", "Marshall", "Lucy", "Robin", "Nick", "Jordan",
 }
 
-var seededRand *rand.Rand = rand.New(
-	rand.NewSource(time.Now().UnixNano()),
-)
+// RandomSource is a source of pseudo-random numbers.
+// Each game has its own source, so that the game
+// can be reproduced from its seed.
+type RandomSource interface {
+	Intn(n int) int
+	Int63() int64
+	Perm(n int) []int
+}
+
+// lockedRandomSource is a RandomSource,
+// which is safe for concurrent use.
+type lockedRandomSource struct {
+	mutex sync.Mutex
+	rand  *rand.Rand
+}
+
+// NewRandomSource returns a RandomSource, which is safe for
+// concurrent use and generates the same numbers for the same seed.
+func NewRandomSource(seed int64) RandomSource {
+	return &lockedRandomSource{
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (r *lockedRandomSource) Intn(n int) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.rand.Intn(n)
+}
+
+func (r *lockedRandomSource) Int63() int64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.rand.Int63()
+}
+
+func (r *lockedRandomSource) Perm(n int) []int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.rand.Perm(n)
+}
+
+// seededRand is used for randomness, which doesn't
+// belong to any game, e.g. choosing questions.
+var seededRand = NewRandomSource(time.Now().UnixNano())
 
 func RandStringWithCharset(length int, charset string) string {
 	// for English letters, we can just use bytes instead
@@ -53,9 +96,9 @@ func RandBool() bool {
 
 // RandShuffle returns the randonly shuffled version
 // of the slice
-func RandShuffle(src []int32) []int32 {
+func RandShuffle(random RandomSource, src []int32) []int32 {
 	dest := make([]int32, len(src))
-	perm := rand.Perm(len(src))
+	perm := random.Perm(len(src))
 	for i, v := range perm {
 		dest[v] = src[i]
 	}
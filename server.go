@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
@@ -15,47 +16,390 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+var questionDifficultiesFromPB = map[pb.QuestionDifficulty]string{
+	pb.QuestionDifficulty_EASY:   easyDifficulty,
+	pb.QuestionDifficulty_MEDIUM: mediumDifficulty,
+	pb.QuestionDifficulty_HARD:   hardDifficulty,
+}
+
+var questionDifficultiesToPB = map[string]pb.QuestionDifficulty{
+	easyDifficulty:   pb.QuestionDifficulty_EASY,
+	mediumDifficulty: pb.QuestionDifficulty_MEDIUM,
+	hardDifficulty:   pb.QuestionDifficulty_HARD,
+}
+
+var questionTypesFromPB = map[pb.QuestionType]string{
+	pb.QuestionType_MULTIPLE: multipleQuestionType,
+	pb.QuestionType_BOOLEAN:  booleanQuestionType,
+	pb.QuestionType_NUMERIC:  numericQuestionType,
+}
+
+var questionTypesToPB = map[string]pb.QuestionType{
+	multipleQuestionType: pb.QuestionType_MULTIPLE,
+	booleanQuestionType:  pb.QuestionType_BOOLEAN,
+	numericQuestionType:  pb.QuestionType_NUMERIC,
+}
+
+// summaryRetentionTime is the time, during which
+// the summary of the finished game can be requested.
+const summaryRetentionTime = time.Hour
+
 // Server is a type for the server, which will
 // track the games, serve the user requests, maintain
 // money invariant, and broadcast events to users.
 type Server struct {
-	listener    net.Listener
-	mutex       sync.RWMutex
-	gameConfig  GameConfig
-	waitingGame *game
-	activeGames map[gameID]*game
+	listener      net.Listener
+	mutex         sync.RWMutex
+	gameConfig    GameConfig
+	questionCache *questionCache
+	waitingGame   *game            // quick-match game
+	lobbies       map[string]*game // waiting games by join code
+	activeGames   map[gameID]*game
+	rematches     map[gameID]*rematch        // rematch lobbies by finished game id
+	summaries     map[gameID]*pb.GameSummary // summaries of finished games
+	clock         Clock
 }
 
 // NewServer will return a new instance of the server.
-func NewServer(gameConfig GameConfig) *Server {
-	return &Server{
-		gameConfig:  gameConfig,
-		waitingGame: newGame(gameConfig),
-		activeGames: make(map[gameID]*game),
+// All games of the server will take their questions
+// from the provided question provider. The questions are
+// prefetched, so that games don't wait for the provider.
+func NewServer(gameConfig GameConfig, questionProvider QuestionProvider) *Server {
+	return NewServerWithClock(gameConfig, questionProvider, NewRealClock())
+}
+
+// NewServerWithClock returns a new instance of the server,
+// whose games use the provided clock for all timers.
+func NewServerWithClock(gameConfig GameConfig, questionProvider QuestionProvider, clock Clock) *Server {
+	questionCache := newQuestionCache(questionProvider, questionCacheSize)
+	s := &Server{
+		gameConfig:    gameConfig,
+		questionCache: questionCache,
+		lobbies:       make(map[string]*game),
+		activeGames:   make(map[gameID]*game),
+		rematches:     make(map[gameID]*rematch),
+		summaries:     make(map[gameID]*pb.GameSummary),
+		clock:         clock,
 	}
+	s.waitingGame = s.newGame(gameConfig)
+	return s
 }
 
-// Join adds a player to the game.
+// newGame creates a new waiting game, which is started
+// and finished by the server, when its timers fire.
+func (s *Server) newGame(config GameConfig) *game {
+	game := newGame(config, s.questionCache, s.clock)
+	game.onCountdownEnd = func() {
+		s.startAfterCountdown(game)
+	}
+	game.onDurationEnd = func() {
+		s.finishAfterDuration(game)
+	}
+	return game
+}
+
+// Join adds a player to the lobby with the join code,
+// or to the quick-match game if the code is empty.
+// The game is started, when it is full, so that next
+// players join a new quick-match game.
 func (s *Server) Join(_ context.Context, req *pb.JoinRequest) (*pb.JoinResponse, error) {
+	// write lock, since the game may start
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	game := s.waitingGame
+	if req.GetJoinCode() != "" {
+		lobby, ok := s.lobbies[normalizeJoinCode(req.GetJoinCode())]
+		if !ok {
+			err := fmt.Errorf("there is no lobby with code %q", req.GetJoinCode())
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		// only players of the finished game can join
+		// the rematch during the rematch time
+		if s.isRematchLobby(lobby) {
+			err := fmt.Errorf("lobby with code %q is a rematch, it can be joined only with Rematch", req.GetJoinCode())
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		game = lobby
+	}
+
+	return s.addPlayer(game, username(req.GetUsername()))
+}
+
+// CreateLobby creates a new waiting game with a join code
+// and adds its creator to it as the host.
+func (s *Server) CreateLobby(_ context.Context, req *pb.CreateLobbyRequest) (*pb.JoinResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	game := s.newLobby(s.gameConfig, req.GetName(), req.GetPublic())
+	return s.addPlayer(game, username(req.GetUsername()))
+}
+
+// newLobby creates a new waiting game with a join code.
+// NOTE: server write lock has to be held by the caller.
+func (s *Server) newLobby(config GameConfig, name string, public bool) *game {
+	game := s.newGame(config)
+	game.joinCode = newJoinCode(func(code string) bool {
+		_, ok := s.lobbies[code]
+		return ok
+	})
+	game.lobbyName = name
+	game.public = public
+	s.lobbies[game.joinCode] = game
+	log.Printf("Lobby %q has been created with code %s\n", game.lobbyName, game.joinCode)
+	return game
+}
+
+// Rematch adds the player of the finished game to its rematch lobby.
+// The player joins with the same username, but gets a new user id.
+func (s *Server) Rematch(_ context.Context, req *pb.RematchRequest) (*pb.JoinResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	rematch, ok := s.rematches[reqGameID]
+	if !ok {
+		err := fmt.Errorf("there is no rematch for game %v, it may have expired", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	username, ok := rematch.roster[reqUserID]
+	if !ok {
+		err := fmt.Errorf("player %v hasn't played game %v", reqUserID, reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if rematch.joined[reqUserID] {
+		err := fmt.Errorf("player %v has already joined the rematch", reqUserID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if s.getWaitingGame(rematch.lobby.gameID) == nil {
+		err := fmt.Errorf("rematch of game %v has already started or has been closed", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.addPlayer(rematch.lobby, username)
+	if err != nil {
+		return nil, err
+	}
+	rematch.joined[reqUserID] = true
+	return res, nil
+}
+
+// createRematch creates the rematch lobby with the config of the
+// finished game for the rematch time. It returns the id of the lobby,
+// or empty id, if rematches are disabled.
+// NOTE: server write lock has to be held by the caller.
+func (s *Server) createRematch(game *game) gameID {
+	if game.config.rematchTime == 0 {
+		return ""
+	}
+	roster, err := game.getRoster()
+	if err != nil {
+		return ""
+	}
+
+	lobby := s.newLobby(game.config, game.lobbyName, false)
+	s.rematches[game.gameID] = &rematch{
+		lobby:  lobby,
+		roster: roster,
+		joined: make(map[userID]bool),
+	}
+	s.clock.AfterFunc(time.Duration(game.config.rematchTime)*time.Second, func() {
+		s.closeRematch(game.gameID)
+	})
+	return lobby.gameID
+}
+
+// isRematchLobby returns true, if the lobby is the rematch
+// of a finished game, whose rematch time hasn't ended.
+// NOTE: server mutex has to be held by the caller.
+func (s *Server) isRematchLobby(lobby *game) bool {
+	for _, rematch := range s.rematches {
+		if rematch.lobby == lobby {
+			return true
+		}
+	}
+	return false
+}
+
+// closeRematch ends the rematch time of the finished game.
+// Rematch lobby is closed, if no one has joined it.
+func (s *Server) closeRematch(gameID gameID) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	rematch, ok := s.rematches[gameID]
+	if !ok {
+		return
+	}
+	delete(s.rematches, gameID)
+	lobby := rematch.lobby
+	if s.getWaitingGame(lobby.gameID) == nil {
+		return
+	}
+	playerCount, err := lobby.playerCount()
+	if err == nil && playerCount == 0 {
+		delete(s.lobbies, lobby.joinCode)
+		lobby.finish("")
+		log.Printf("Lobby %s has been closed\n", lobby.joinCode)
+	}
+}
+
+// ConfigureLobby replaces the config of the lobby, if
+// it is requested by the host before the start.
+func (s *Server) ConfigureLobby(
+	_ context.Context, req *pb.ConfigureLobbyRequest,
+) (*pb.ConfigureLobbyResponse, error) {
+	// write lock, since the config of the game is read under read lock
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game := s.getWaitingGame(reqGameID)
+	if game == nil {
+		err := fmt.Errorf("there is no waiting game with id %v", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if req.GetConfig() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "config is missing")
+	}
+
+	config := applyPBGameConfig(s.gameConfig, req.GetConfig())
+	if err := config.Validate(); err != nil {
+		err = fmt.Errorf("invalid game config: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	started, err := game.configure(reqUserID, config)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	log.Printf("Lobby %s has been configured by %v\n", game.joinCode, reqUserID)
+	if started {
+		s.activate(game)
+	}
+	return &pb.ConfigureLobbyResponse{}, nil
+}
+
+// Kick deletes the player from the waiting game, if
+// it is requested by the host.
+func (s *Server) Kick(_ context.Context, req *pb.KickRequest) (*pb.KickResponse, error) {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
-	reqUsername := username(req.GetUsername())
-	userID := s.waitingGame.addPlayer(reqUsername)
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+	reqKickedUserID := userID(req.GetKickedUserId())
+
+	game := s.getWaitingGame(reqGameID)
+	if game == nil {
+		err := fmt.Errorf("there is no waiting game with id %v", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := game.kickPlayer(reqUserID, reqKickedUserID); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	log.Printf("Player %v has been kicked from game %v\n", reqKickedUserID, reqGameID)
+	return &pb.KickResponse{}, nil
+}
+
+// LockLobby locks or unlocks the lobby for new
+// players, if it is requested by the host.
+func (s *Server) LockLobby(_ context.Context, req *pb.LockLobbyRequest) (*pb.LockLobbyResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game := s.getWaitingGame(reqGameID)
+	if game == nil {
+		err := fmt.Errorf("there is no waiting game with id %v", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := game.lock(reqUserID, req.GetLocked()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.LockLobbyResponse{}, nil
+}
+
+// ListLobbies returns public lobbies, which can be joined.
+func (s *Server) ListLobbies(_ context.Context, _ *pb.ListLobbiesRequest) (*pb.ListLobbiesResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
 
-	res := s.getJoinResponseMessage(userID, s.waitingGame)
+	res := &pb.ListLobbiesResponse{}
+	for _, game := range s.lobbies {
+		if !game.public {
+			continue
+		}
+		playerCount, err := game.playerCount()
+		if err != nil {
+			// the lobby has been closed in the meantime
+			continue
+		}
+		res.Lobbies = append(res.Lobbies, &pb.ListLobbiesResponse_Lobby{
+			JoinCode:    game.joinCode,
+			Name:        game.lobbyName,
+			PlayerCount: int32(playerCount),
+		})
+	}
+	sort.Slice(res.Lobbies, func(i, j int) bool {
+		return res.Lobbies[i].JoinCode < res.Lobbies[j].JoinCode
+	})
+	return res, nil
+}
+
+// NOTE: server write lock has to be held by the caller.
+func (s *Server) addPlayer(game *game, username username) (*pb.JoinResponse, error) {
+	userID, started, err := game.addPlayer(username)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if started {
+		log.Printf("Game %v has been started, since it is full\n", game.gameID)
+		s.activate(game)
+	}
+
+	res, err := s.getJoinResponseMessage(userID, game)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
 	return res, nil
 }
 
+// getWaitingGame returns the quick-match game or the lobby
+// with the provided id, or nil if there is no such game.
+// NOTE: server mutex has to be held by the caller.
+func (s *Server) getWaitingGame(gameID gameID) *game {
+	if s.waitingGame.gameID == gameID {
+		return s.waitingGame
+	}
+	for _, game := range s.lobbies {
+		if game.gameID == gameID {
+			return game
+		}
+	}
+	return nil
+}
+
 // Leave deleted player from the waiting game.
+// Lobby is closed, when the last player leaves it.
 func (s *Server) Leave(_ context.Context, req *pb.LeaveRequest) (*pb.LeaveResponse, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
 
-	if s.waitingGame.gameID != reqGameID {
+	game := s.getWaitingGame(reqGameID)
+	if game == nil {
 		err := fmt.Errorf(
 			"game with id %v doesn't exist or has been already started (can't join active game)",
 			reqGameID,
@@ -63,44 +407,150 @@ func (s *Server) Leave(_ context.Context, req *pb.LeaveRequest) (*pb.LeaveRespon
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	s.waitingGame.deletePlayer(reqUserID)
+	playerCount, err := game.deletePlayer(reqUserID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if playerCount == 0 && game != s.waitingGame {
+		delete(s.lobbies, game.joinCode)
+		game.finish("")
+		log.Printf("Lobby %s has been closed\n", game.joinCode)
+	}
 	return &pb.LeaveResponse{}, nil
 }
 
 // Start will start the game, i.e. change it from "waiting" to "active".
-// New waiting game will be created for other users to join.
+// Only the host can start the game.
 func (s *Server) Start(_ context.Context, req *pb.StartRequest) (*pb.StartResponse, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
 	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
 
-	if s.waitingGame.gameID != reqGameID {
-		log.Printf(
-			"attempt to start game with id different from waiting game id; have: %v, want %v\n",
-			reqGameID,
-			s.waitingGame.gameID,
-		)
+	game := s.getWaitingGame(reqGameID)
+	if game == nil {
+		log.Printf("attempt to start game with id %v, which is not waiting\n", reqGameID)
 		// ignore the error
 		return &pb.StartResponse{}, nil
 	}
 
-	game := s.waitingGame
-	game.start()
+	if err := game.start(reqUserID); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	s.activate(game)
+
+	return &pb.StartResponse{}, nil
+}
+
+// startAfterCountdown starts the game, when its countdown ends,
+// unless the game has been closed or started in the meantime.
+func (s *Server) startAfterCountdown(game *game) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.getWaitingGame(game.gameID) != game {
+		return
+	}
+	started, err := game.startAfterCountdown()
+	if err != nil || !started {
+		return
+	}
+	log.Printf("Game %v has been started after the countdown\n", game.gameID)
+	s.activate(game)
+}
+
+// activate moves the started game to active games, which are finished
+// by finishAfterDuration. If it is the quick-match game, new waiting game will be
+// created for other users to join. Started lobby can't be joined anymore.
+// NOTE: server write lock has to be held by the caller.
+func (s *Server) activate(game *game) {
 	s.activeGames[game.gameID] = game
-	// count down until game finishes
-	time.AfterFunc(time.Duration(game.config.duration)*time.Second, func() {
-		s.mutex.Lock()
-		defer s.mutex.Unlock()
-		delete(s.activeGames, game.gameID)
 
-		game.finish()
-	})
+	if game == s.waitingGame {
+		// create a new quick-match game
+		s.waitingGame = s.newGame(s.gameConfig)
+	} else {
+		delete(s.lobbies, game.joinCode)
+	}
+}
 
-	// create a new waiting game
-	s.waitingGame = newGame(s.gameConfig)
+// finishAfterDuration finishes the game, when its duration
+// has passed, unless it has been paused in the meantime.
+// Rematch lobby is created for the players of the game.
+func (s *Server) finishAfterDuration(game *game) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
-	return &pb.StartResponse{}, nil
+	over, err := game.durationIsOver()
+	if err != nil || !over {
+		return
+	}
+	delete(s.activeGames, game.gameID)
+	summary, err := game.finish(s.createRematch(game))
+	if err == nil {
+		s.summaries[game.gameID] = summary
+		s.clock.AfterFunc(summaryRetentionTime, func() {
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+			delete(s.summaries, game.gameID)
+		})
+	}
+}
+
+// PauseGame stops the timers of the active game, if
+// it is requested by the host. Points can't be moved,
+// until the game is resumed.
+func (s *Server) PauseGame(_ context.Context, req *pb.PauseGameRequest) (*pb.PauseGameResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, ok := s.activeGames[reqGameID]
+	if !ok {
+		err := fmt.Errorf("there is no active game with id %v", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := game.pause(reqUserID); err != nil {
+		return nil, gameStatusError(err)
+	}
+	log.Printf("Game %v has been paused\n", reqGameID)
+	return &pb.PauseGameResponse{}, nil
+}
+
+// ResumeGame starts the timers of the paused game again,
+// if it is requested by the host.
+func (s *Server) ResumeGame(_ context.Context, req *pb.ResumeGameRequest) (*pb.ResumeGameResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, ok := s.activeGames[reqGameID]
+	if !ok {
+		err := fmt.Errorf("there is no active game with id %v", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	if err := game.resume(reqUserID); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	log.Printf("Game %v has been resumed\n", reqGameID)
+	return &pb.ResumeGameResponse{}, nil
+}
+
+// gameStatusError returns the status for the error of the game.
+// Requests, which are rejected, since the game is paused, can be
+// repeated after the game resumes.
+func gameStatusError(err error) error {
+	if err == errGamePaused {
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.InvalidArgument, err.Error())
 }
 
 // Credit will check if the credit can be granted. It will return "True" for success, if
@@ -128,7 +578,7 @@ func (s *Server) Credit(_ context.Context, req *pb.CreditRequest) (*pb.CreditRes
 
 	success, explanation, err := game.useCredit(reqUserID, reqVal)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, gameStatusError(err)
 	}
 
 	return s.getCreditResponseMessage(success, explanation), nil
@@ -159,7 +609,7 @@ func (s *Server) Deposit(_ context.Context, req *pb.DepositRequest) (*pb.Deposit
 
 	success, explanation, err := game.useDeposit(reqUserID, reqVal)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, gameStatusError(err)
 	}
 
 	return s.getDepositResponseMessage(success, explanation), nil
@@ -181,18 +631,43 @@ func (s *Server) Lottery(_ context.Context, req *pb.LotteryRequest) (*pb.Lottery
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	// TODO: ideally, 1 and 9 have to be in game config and not be exact numbers in code.
-	if reqCellIndex < 1 || reqCellIndex > 9 {
-		err := fmt.Errorf("cell index has to be from 1 to 9, received: %d", reqCellIndex)
+	cellCount := game.config.lotteryCellCount
+	if reqCellIndex < 1 || reqCellIndex > cellCount {
+		err := fmt.Errorf("cell index has to be from 1 to %d, received: %d", cellCount, reqCellIndex)
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	success, cellValues, winPoints, err := game.playLottery(reqUserID, reqCellIndex)
+	success, cellValues, winPoints, commitment, err := game.playLottery(reqUserID, reqCellIndex)
+	if err != nil {
+		return nil, gameStatusError(err)
+	}
+
+	return s.getLotteryResponseMessage(success, cellValues, winPoints, commitment), nil
+}
+
+// LotteryCommitment commits the shuffled board for the next lottery of
+// the player. The board and salt are revealed in the lottery response.
+func (s *Server) LotteryCommitment(
+	_ context.Context, req *pb.LotteryCommitmentRequest,
+) (*pb.LotteryCommitmentResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game, ok := s.activeGames[reqGameID]
+	if !ok {
+		err := fmt.Errorf("there is no active game with id %v", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	commitment, err := game.commitLottery(reqUserID)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	return s.getLotteryResponseMessage(success, cellValues, winPoints), nil
+	return &pb.LotteryCommitmentResponse{Commitment: commitment}, nil
 }
 
 func (s *Server) GenerateQuestion(_ context.Context, req *pb.GenerateQuestionRequest) (*pb.GenerateQuestionResponse, error) {
@@ -214,9 +689,28 @@ func (s *Server) GenerateQuestion(_ context.Context, req *pb.GenerateQuestionReq
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	questionID, question, answers, err := game.doGenerateQuestion(reqUserID, reqBidPoints)
+	difficulty, ok := questionDifficultiesFromPB[req.GetDifficulty()]
+	if !ok {
+		err := fmt.Errorf("unknown question difficulty %v", req.GetDifficulty())
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	reqCategory := req.GetCategory()
+	if reqCategory != "" && !containsString(game.questionCache.categories(), reqCategory) {
+		err := fmt.Errorf("unknown question category %q", reqCategory)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	questionType, ok := questionTypesFromPB[req.GetType()]
+	if !ok {
+		err := fmt.Errorf("unknown question type %v", req.GetType())
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	filter := QuestionFilter{Type: questionType, Difficulty: difficulty, Category: reqCategory}
+	questionID, question, answers, err := game.doGenerateQuestion(reqUserID, reqBidPoints, filter)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		return nil, gameStatusError(err)
 	}
 
 	return s.getGenerateQuestionResponseMessage(questionID, question, answers), nil
@@ -237,88 +731,314 @@ func (s *Server) AnswerQuestion(_ context.Context, req *pb.AnswerQuestionRequest
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	if reqAnswer < 1 || reqAnswer > 4 {
-		err := fmt.Errorf("user answer has to be an integer from 1 to 4, received: %d", reqAnswer)
+	answerMin, answerMax, err := game.getQuestionAnswerRange(reqUserID, reqQuestionID)
+	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	answerIsCorrect, correctAnswer, winPoints, err := game.doAnswerQuestion(
+	if reqAnswer < answerMin || reqAnswer > answerMax {
+		err := fmt.Errorf(
+			"user answer has to be an integer from %d to %d, received: %d", answerMin, answerMax, reqAnswer,
+		)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	answerIsCorrect, correctAnswer, winPoints, expired, err := game.doAnswerQuestion(
 		reqUserID, reqQuestionID, reqAnswer,
 	)
+	if err != nil {
+		return nil, gameStatusError(err)
+	}
+
+	return s.getAnswerQuestionResponseMessage(answerIsCorrect, correctAnswer, winPoints, expired), nil
+}
+
+// GetGameState returns the current timers of the waiting or active game
+// for the player, so that the client can correct its countdowns.
+func (s *Server) GetGameState(_ context.Context, req *pb.GetGameStateRequest) (*pb.GetGameStateResponse, error) {
+	reqGameID := gameID(req.GetGameId())
+	reqUserID := userID(req.GetUserId())
+
+	game := s.getStreamGame(reqGameID)
+	if game == nil {
+		err := fmt.Errorf("game with id %v doesn't exist or is finished", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	remainingTime, theftWaitTime, lotteryWaitTime, paused, err := game.getState(reqUserID)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	return s.getAnswerQuestionResponseMessage(answerIsCorrect, correctAnswer, winPoints), nil
+	return s.getGameStateResponseMessage(remainingTime, theftWaitTime, lotteryWaitTime, paused), nil
 }
 
-// Stream opens the server stream with the user.
-func (s *Server) Stream(req *pb.StreamRequest, srv pb.Game_StreamServer) error {
+// GetGameSummary returns the summary of the finished game
+// during summaryRetentionTime after the finish.
+func (s *Server) GetGameSummary(_ context.Context, req *pb.GetGameSummaryRequest) (*pb.GetGameSummaryResponse, error) {
 	s.mutex.RLock()
+	defer s.mutex.RUnlock()
 
-	var game *game = nil
+	reqGameID := gameID(req.GetGameId())
+	summary, ok := s.summaries[reqGameID]
+	if !ok {
+		err := fmt.Errorf("there is no finished game with id %v", reqGameID)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.GetGameSummaryResponse{Summary: summary}, nil
+}
+
+// Stream opens the server stream with the user.
+func (s *Server) Stream(req *pb.StreamRequest, srv pb.Game_StreamServer) error {
 	reqGameID := gameID(req.GetGameId())
 	reqUserID := userID(req.GetUserId())
 
-	if reqGameID == s.waitingGame.gameID {
-		game = s.waitingGame
-	} else if g, ok := s.activeGames[reqGameID]; ok {
-		game = g
+	game := s.getStreamGame(reqGameID)
+	if game == nil {
+		return status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+
+	missedEvents, subscriber, err := game.setPlayerStream(reqUserID, req.GetLastSeenSequence())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to set player stream: %v", err)
 	}
 
-	// WARNING: pay attention to this
-	// when modifying code.
-	s.mutex.RUnlock()
+	return serveSubscriber(srv, game, reqUserID, subscriber, missedEvents)
+}
+
+// Resume sends the snapshot of the game to the player with the
+// reconnect token and then continues as the stream of the player.
+func (s *Server) Resume(req *pb.ResumeRequest, srv pb.Game_ResumeServer) error {
+	reqGameID := gameID(req.GetGameId())
 
+	game := s.getStreamGame(reqGameID)
 	if game == nil {
 		return status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
 	}
 
-	err := game.setPlayerStream(reqUserID, srv)
+	userID, snapshot, subscriber, err := game.resumePlayer(req.GetReconnectToken())
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "failed to set player stream: %v", err)
+		return status.Errorf(codes.InvalidArgument, "failed to resume the game: %v", err)
+	}
+	log.Printf("Player %v has resumed game %v\n", userID, game.gameID)
+
+	return serveSubscriber(srv, game, userID, subscriber, []*pb.StreamResponse{snapshot})
+}
+
+// Spectate streams events of the game to the spectator, who
+// doesn't join the game. All events are sent first, unless
+// the last seen sequence is provided.
+func (s *Server) Spectate(req *pb.SpectateRequest, srv pb.Game_SpectateServer) error {
+	reqGameID := gameID(req.GetGameId())
+
+	game := s.getStreamGame(reqGameID)
+	if game == nil {
+		return status.Errorf(codes.InvalidArgument, "game with id %v doesn't exist or is finished", reqGameID)
+	}
+
+	missedEvents, subscriber, err := game.addSpectator(req.GetLastSeenSequence())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to spectate the game: %v", err)
+	}
+	log.Printf("Spectator has joined game %v\n", game.gameID)
+
+	// spectator has no user id
+	return serveSubscriber(srv, game, "", subscriber, missedEvents)
+}
+
+// getStreamGame returns the waiting or active game with
+// the provided id, or nil if there is no such game.
+func (s *Server) getStreamGame(gameID gameID) *game {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if game := s.getWaitingGame(gameID); game != nil {
+		return game
+	}
+	return s.activeGames[gameID]
+}
+
+// serveSubscriber sends first events and then events queued for the
+// subscriber to the stream, until the game is finished, the stream is
+// closed by the client or the subscriber is disconnected by the game.
+// All sends to the stream happen in the goroutine of the handler.
+func serveSubscriber(
+	srv pb.Game_StreamServer, game *game, userID userID, subscriber *subscriber, firstEvents []*pb.StreamResponse,
+) error {
+	for _, response := range firstEvents {
+		if err := srv.Send(response); err != nil {
+			game.unsubscribe(userID, subscriber)
+			return err
+		}
 	}
 
-	ctx := srv.Context()
 	for {
-		if ctx.Err() == context.Canceled || ctx.Err() == context.DeadlineExceeded {
+		select {
+		case response := <-subscriber.events:
+			if err := srv.Send(response); err != nil {
+				game.unsubscribe(userID, subscriber)
+				return err
+			}
+		case <-subscriber.disconnected:
+			// sending events queued before the disconnect,
+			// e.g. the leave event of the kicked player
+			if err := sendQueuedEvents(srv, subscriber); err != nil {
+				return err
+			}
+			return status.Errorf(codes.Aborted, subscriber.disconnectErr.Error())
+		case <-srv.Context().Done():
 			log.Printf("Stream context is cancelled for game %v\n", game.gameID)
+			game.unsubscribe(userID, subscriber)
 			return nil
+		case <-game.stopped:
+			// sending the rest of events including the finish,
+			// since nothing is queued after the game is stopped
+			return sendQueuedEvents(srv, subscriber)
 		}
+	}
+}
 
-		// stor streaming if the game is finished
-		// NOTE: "isFinished" method acquires read lock
-		if game.isFinished() {
+// sendQueuedEvents sends events, which are in the queue of
+// the subscriber, to the stream without waiting for new ones.
+func sendQueuedEvents(srv pb.Game_StreamServer, subscriber *subscriber) error {
+	for {
+		select {
+		case response := <-subscriber.events:
+			if err := srv.Send(response); err != nil {
+				return err
+			}
+		default:
 			return nil
 		}
-
-		time.Sleep(1 * time.Second)
 	}
-
-	return nil
 }
 
 func (s *Server) getJoinResponseMessage(
 	userID userID, game *game,
-) *pb.JoinResponse {
-	game.mutex.RLock()
-	defer game.mutex.RUnlock()
+) (*pb.JoinResponse, error) {
+	var players []*pb.Player
+	var reconnectToken string
+	var hostID string
+	var remainingCountdown int32
+	if err := game.do(func() {
+		players = game.getPBPlayersWithBank()
+		hostID = string(game.hostID)
+		remainingCountdown = game.remainingCountdown()
+		// the player could have left already
+		if player, ok := game.players[userID]; ok {
+			reconnectToken = player.reconnectToken
+		}
+	}); err != nil {
+		return nil, err
+	}
 	return &pb.JoinResponse{
-		UserId:                string(userID),
-		GameId:                string(game.gameID),
-		Players:               game.getPBPlayersWithBank(),
-		Duration:              game.config.duration,
-		PlayerPoints:          game.config.playerPoints,
-		BankPointsPerPlayer:   game.config.bankPointsPerPlayer,
-		CreditInterest:        game.config.creditInterest,
-		DepositInterest:       game.config.depositInterest,
-		CreditTime:            game.config.creditTime,
-		DepositTime:           game.config.depositTime,
-		TheftTime:             game.config.theftTime,
-		TheftPercentage:       game.config.theftPercentage,
-		LotteryTime:           game.config.lotteryTime,
-		LotteryMaxWin:         game.config.lotteryMaxWin,
-		QuestionWinPercentage: game.config.questionWinPercentage,
+		UserId:                 string(userID),
+		GameId:                 string(game.gameID),
+		Players:                players,
+		Duration:               game.config.duration,
+		PlayerPoints:           game.config.playerPoints,
+		BankPointsPerPlayer:    game.config.bankPointsPerPlayer,
+		CreditInterest:         game.config.creditInterest,
+		DepositInterest:        game.config.depositInterest,
+		CreditTime:             game.config.creditTime,
+		DepositTime:            game.config.depositTime,
+		TheftTime:              game.config.theftTime,
+		TheftPercentage:        game.config.theftPercentage,
+		LotteryTime:            game.config.lotteryTime,
+		LotteryMaxWin:          game.config.lotteryMaxWin,
+		QuestionAnswerTime:     game.config.questionAnswerTime,
+		QuestionWinPercentages: getPBQuestionWinPercentages(game.config.questionWinPercentages),
+		QuestionCategories:     game.questionCache.categories(),
+		LotteryCellCount:       game.config.lotteryCellCount,
+		LotteryCellValues:      game.lotteryCellValues,
+		LotteryTicketPrice:     game.config.lotteryTicketPrice,
+		LotteryJackpotShare:    game.config.lotteryJackpotShare,
+		ReconnectToken:         reconnectToken,
+		JoinCode:               game.joinCode,
+		LobbyName:              game.lobbyName,
+		HostUserId:             hostID,
+		MinPlayers:             game.config.minPlayers,
+		MaxPlayers:             game.config.maxPlayers,
+		StartCountdown:         game.config.startCountdown,
+		RemainingCountdown:     remainingCountdown,
+	}, nil
+}
+
+// toPB returns the game settings of the config.
+func (c *GameConfig) toPB() *pb.GameConfig {
+	return &pb.GameConfig{
+		Duration:                   c.duration,
+		PlayerPoints:               c.playerPoints,
+		BankPointsPerPlayer:        c.bankPointsPerPlayer,
+		CreditInterest:             c.creditInterest,
+		DepositInterest:            c.depositInterest,
+		CreditTime:                 c.creditTime,
+		DepositTime:                c.depositTime,
+		TheftTime:                  c.theftTime,
+		TheftPercentage:            c.theftPercentage,
+		LotteryTime:                c.lotteryTime,
+		LotteryMaxWin:              c.lotteryMaxWin,
+		QuestionAnswerTime:         c.questionAnswerTime,
+		QuestionWinPercentages:     getPBQuestionWinPercentages(c.questionWinPercentages),
+		LotteryCellCount:           c.lotteryCellCount,
+		LotteryPayouts:             c.lotteryPayouts,
+		LotteryPayoutsInPercentage: c.lotteryPayoutsInPercentage,
+		LotteryTicketPrice:         c.lotteryTicketPrice,
+		LotteryJackpotShare:        c.lotteryJackpotShare,
+		MinPlayers:                 c.minPlayers,
+		MaxPlayers:                 c.maxPlayers,
+		StartCountdown:             c.startCountdown,
+	}
+}
+
+// applyPBGameConfig returns the config with game settings from pbConfig.
+// Settings of the server, e.g. random seed, are taken from config.
+func applyPBGameConfig(config GameConfig, pbConfig *pb.GameConfig) GameConfig {
+	questionWinPercentages := make(map[string]int32)
+	for _, winPercentage := range pbConfig.QuestionWinPercentages {
+		difficulty := questionDifficultiesFromPB[winPercentage.Difficulty]
+		questionWinPercentages[difficulty] = winPercentage.WinPercentage
+	}
+
+	res := NewGameConfig(
+		pbConfig.Duration, pbConfig.PlayerPoints, pbConfig.BankPointsPerPlayer,
+		pbConfig.CreditInterest, pbConfig.DepositInterest,
+		pbConfig.CreditTime, pbConfig.DepositTime,
+		pbConfig.TheftTime, pbConfig.TheftPercentage,
+		pbConfig.LotteryTime, pbConfig.LotteryMaxWin,
+		questionWinPercentages,
+	)
+	res.SetQuestionAnswerTime(pbConfig.QuestionAnswerTime)
+	res.SetLotteryPayouts(pbConfig.LotteryCellCount, pbConfig.LotteryPayouts, pbConfig.LotteryPayoutsInPercentage)
+	res.SetLotteryTicket(pbConfig.LotteryTicketPrice, pbConfig.LotteryJackpotShare)
+	res.SetAutoStart(pbConfig.MinPlayers, pbConfig.MaxPlayers, pbConfig.StartCountdown)
+	res.randomSeed = config.randomSeed
+	res.randomSeedIsSet = config.randomSeedIsSet
+	res.SetStreamQueue(config.streamQueueSize, config.slowStreamPolicy)
+	res.SetRematchTime(config.rematchTime)
+	return res
+}
+
+func getPBQuestionWinPercentages(winPercentages map[string]int32) []*pb.QuestionWinPercentage {
+	var res []*pb.QuestionWinPercentage
+	for _, difficulty := range QuestionDifficulties {
+		res = append(res, &pb.QuestionWinPercentage{
+			Difficulty:    questionDifficultiesToPB[difficulty],
+			WinPercentage: winPercentages[difficulty],
+		})
+	}
+	return res
+}
+
+func (s *Server) getGameStateResponseMessage(
+	remainingTime int32, theftWaitTime int32, lotteryWaitTime int32, paused bool,
+) *pb.GetGameStateResponse {
+	return &pb.GetGameStateResponse{
+		RemainingTime:   remainingTime,
+		TheftWaitTime:   theftWaitTime,
+		LotteryWaitTime: lotteryWaitTime,
+		Paused:          paused,
+		ServerTime:      unixMillis(s.clock.Now()),
 	}
 }
 
@@ -336,31 +1056,43 @@ func (s *Server) getDepositResponseMessage(success bool, explanation string) *pb
 	}
 }
 
-func (s *Server) getLotteryResponseMessage(success bool, cellValues []int32, winPoints int32) *pb.LotteryResponse {
-	return &pb.LotteryResponse{
+func (s *Server) getLotteryResponseMessage(
+	success bool, cellValues []int32, winPoints int32, commitment *lotteryCommitment,
+) *pb.LotteryResponse {
+	res := &pb.LotteryResponse{
 		Success:    success,
 		CellValues: cellValues,
 		WinPoints:  winPoints,
 	}
+	if commitment != nil {
+		res.Salt = commitment.salt
+		res.Commitment = commitment.commitment
+	}
+	return res
 }
 
 func (s *Server) getGenerateQuestionResponseMessage(
-	questionID questionID, question string, answers []string,
+	questionID questionID, question *Question, answers []string,
 ) *pb.GenerateQuestionResponse {
+	answerMin, answerMax := question.answerRange()
 	return &pb.GenerateQuestionResponse{
 		QuestionId: string(questionID),
-		Question:   question,
+		Question:   question.Text,
 		Answers:    answers,
+		Type:       questionTypesToPB[question.Type],
+		AnswerMin:  answerMin,
+		AnswerMax:  answerMax,
 	}
 }
 
 func (s *Server) getAnswerQuestionResponseMessage(
-	answerIsCorrect bool, correctAnswer int32, winPoints int32,
+	answerIsCorrect bool, correctAnswer int32, winPoints int32, expired bool,
 ) *pb.AnswerQuestionResponse {
 	return &pb.AnswerQuestionResponse{
 		AnswerIsCorrect: answerIsCorrect,
 		CorrectAnswer:   correctAnswer,
 		WinPoints:       winPoints,
+		Expired:         expired,
 	}
 }
 
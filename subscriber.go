@@ -0,0 +1,58 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/cs489-team11/server/pb"
+)
+
+// Policies for streams, which don't receive events
+// as fast as they happen, i.e. whose queue is full.
+const (
+	// event is not sent to the stream, the player can
+	// notice the gap in sequence numbers and reopen the stream
+	dropSlowStreamPolicy = "drop"
+	// stream is closed, the player has to reopen it
+	// with the last seen sequence
+	disconnectSlowStreamPolicy = "disconnect"
+)
+
+// SlowStreamPolicies lists all policies for slow streams.
+var SlowStreamPolicies = []string{dropSlowStreamPolicy, disconnectSlowStreamPolicy}
+
+// subscriber is the stream of a single player. Events are queued by
+// the event loop of the game and sent by the goroutine of the stream
+// handler, since gRPC doesn't allow concurrent sends on one stream.
+type subscriber struct {
+	events chan *pb.StreamResponse
+	// closed, when the game doesn't send events to the subscriber anymore
+	disconnected chan struct{}
+	// reason of the disconnect, can be read after disconnected is closed
+	disconnectErr error
+}
+
+func newSubscriber(queueSize int32) *subscriber {
+	return &subscriber{
+		events:       make(chan *pb.StreamResponse, queueSize),
+		disconnected: make(chan struct{}),
+	}
+}
+
+// enqueue adds the event to the queue without blocking.
+// It returns false, if the queue is full.
+// NOTE: has to be called in the event loop of the game.
+func (s *subscriber) enqueue(response *pb.StreamResponse) bool {
+	select {
+	case s.events <- response:
+		return true
+	default:
+		return false
+	}
+}
+
+// disconnect tells the stream handler to close the stream.
+// NOTE: has to be called in the event loop of the game at most once.
+func (s *subscriber) disconnect(format string, args ...interface{}) {
+	s.disconnectErr = fmt.Errorf(format, args...)
+	close(s.disconnected)
+}
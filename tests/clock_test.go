@@ -0,0 +1,229 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManualClock(t *testing.T) {
+	start := time.Date(2020, 11, 1, 12, 0, 0, 0, time.UTC)
+	clock := server.NewManualClock(start)
+
+	var calls []string
+	clock.AfterFunc(3*time.Second, func() { calls = append(calls, "3s") })
+	clock.AfterFunc(1*time.Second, func() {
+		calls = append(calls, "1s")
+		// scheduled from another call
+		clock.AfterFunc(1*time.Second, func() { calls = append(calls, "2s") })
+	})
+	stopped := clock.AfterFunc(2*time.Second, func() { calls = append(calls, "stopped") })
+	require.True(t, stopped.Stop())
+	require.False(t, stopped.Stop())
+
+	clock.Advance(2 * time.Second)
+	require.Equal(t, []string{"1s", "2s"}, calls)
+	require.Equal(t, start.Add(2*time.Second), clock.Now())
+
+	clock.Advance(5 * time.Second)
+	require.Equal(t, []string{"1s", "2s", "3s"}, calls)
+	require.Equal(t, start.Add(7*time.Second), clock.Now())
+}
+
+func TestGameWithManualClock(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetQuestionAnswerTime(5)
+	addr, clock := launchManualClockServer(t, config)
+
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+	require.NoError(t, client.OpenStream())
+
+	res1, err := client.PlayLottery(1)
+	require.NoError(t, err)
+	require.False(t, res1.Success)
+
+	clock.Advance(2 * time.Second)
+	res2, err := client.PlayLottery(1)
+	require.NoError(t, err)
+	require.True(t, res2.Success)
+
+	res3, err := client.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, res3.Success)
+	clock.Advance(1 * time.Second)
+	returnCredit := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetTransaction().GetReturnCredit() != nil
+	})
+	require.Equal(t, int32(130), returnCredit.GetTransaction().GetReturnCredit().Value)
+
+	// the question expires at the deadline, so the answer isn't accepted
+	res4, err := client.DoGenerateQuestion(10)
+	require.NoError(t, err)
+	clock.Advance(6 * time.Second)
+	expired := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetTransaction().GetQuestion() != nil
+	})
+	require.True(t, expired.GetTransaction().GetQuestion().GetExpired())
+	res5, err := client.DoAnswerQuestion(res4.QuestionId, 1)
+	require.NoError(t, err)
+	require.True(t, res5.Expired)
+	require.Equal(t, int32(0), res5.WinPoints)
+
+	clock.Advance(21 * time.Second)
+	finish := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	})
+	require.Equal(t, string(client.UserID), finish.GetFinish().WinnerUserId)
+
+	_, err = client.PlayLottery(1)
+	require.NotNil(t, err)
+}
+
+func TestFinishSettlesCreditsAndDeposits(t *testing.T) {
+	addr, clock := launchManualClockServer(t, newTestGameConfig())
+
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+	require.NoError(t, client.OpenStream())
+
+	// credit and deposit are due after the finish
+	clock.Advance(29500 * time.Millisecond)
+	res1, err := client.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, res1.Success)
+	res2, err := client.TakeDeposit(50)
+	require.NoError(t, err)
+	require.True(t, res2.Success)
+
+	clock.Advance(500 * time.Millisecond)
+	finish := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	})
+
+	// 200 - 30 (theft) + 100 (credit) - 50 (deposit) - 130 (credit return) + 60 (deposit return)
+	total := int32(0)
+	for _, player := range finish.GetFinish().Players {
+		if player.UserId == string(client.UserID) {
+			require.Equal(t, int32(150), player.Points)
+		}
+		total += player.Points
+	}
+	require.Equal(t, int32(600), total)
+
+	// nothing happens in the finished game
+	clock.Advance(30 * time.Second)
+}
+
+func TestPauseGame(t *testing.T) {
+	addr, clock := launchManualClockServer(t, newTestGameConfig())
+
+	host := server.NewSampleClient()
+	guest := server.NewSampleClient()
+	startTestGame(t, addr, host, guest)
+	require.NoError(t, host.OpenStream())
+	receiveEvent(t, host, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+	clock.Advance(10 * time.Second)
+	res1, err := host.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, res1.Success)
+	receiveEvent(t, host, func(res *pb.StreamResponse) bool {
+		return res.GetTransaction() != nil
+	})
+
+	// only the host can pause the game
+	clock.Advance(500 * time.Millisecond)
+	require.NotNil(t, guest.PauseGame())
+	require.NotNil(t, host.ResumeGame())
+	require.NoError(t, host.PauseGame())
+	res, err := host.ReceiveEvent()
+	require.NoError(t, err)
+	require.Equal(t, int32(20), res.GetPaused().GetRemainingTime())
+
+	// points can't be moved and timers don't run
+	_, err = guest.TakeDeposit(10)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "FailedPrecondition")
+	_, err = guest.PlayLottery(1)
+	require.NotNil(t, err)
+	clock.Advance(1000 * time.Second)
+	snapshot, err := guest.Resume()
+	require.NoError(t, err)
+	require.True(t, snapshot.Paused)
+	require.Equal(t, int32(20), snapshot.RemainingTime)
+
+	require.NoError(t, host.ResumeGame())
+	res, err = host.ReceiveEvent()
+	require.NoError(t, err)
+	require.Equal(t, int32(20), res.GetResumed().GetRemainingTime())
+
+	// credit is returned a second after it has been taken, not counting the pause
+	clock.Advance(400 * time.Millisecond)
+	res2, err := guest.TakeDeposit(10)
+	require.NoError(t, err)
+	require.True(t, res2.Success)
+	res, err = host.ReceiveEvent()
+	require.NoError(t, err)
+	require.NotNil(t, res.GetTransaction().GetUseDeposit())
+	clock.Advance(100 * time.Millisecond)
+	res, err = host.ReceiveEvent()
+	require.NoError(t, err)
+	require.Equal(t, int32(130), res.GetTransaction().GetReturnCredit().GetValue())
+
+	clock.Advance(19 * time.Second)
+	receiveEvent(t, host, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	})
+}
+
+func TestGameState(t *testing.T) {
+	addr, clock := launchManualClockServer(t, newTestGameConfig())
+
+	client := server.NewSampleClient()
+	require.NoError(t, client.Connect(addr))
+	_, err := client.JoinGame()
+	require.NoError(t, err)
+
+	// times of the waiting game are the ones from the config
+	state, err := client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(30), state.RemainingTime)
+	require.Equal(t, int32(25), state.TheftWaitTime)
+	require.Equal(t, int32(2), state.LotteryWaitTime)
+
+	nowMillis := clock.Now().UnixNano() / int64(time.Millisecond)
+	require.NoError(t, client.StartGame())
+	require.NoError(t, client.OpenStream())
+	res := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+	require.Equal(t, nowMillis, res.GetStart().StartTime)
+	require.Equal(t, nowMillis+30*1000, res.GetStart().EndTime)
+
+	clock.Advance(1 * time.Second)
+	_, err = client.TakeCredit(10)
+	require.NoError(t, err)
+	res, err = client.ReceiveEvent()
+	require.NoError(t, err)
+	require.Equal(t, nowMillis+1*1000, res.GetTransaction().Time)
+
+	state, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(29), state.RemainingTime)
+	require.Equal(t, int32(24), state.TheftWaitTime)
+	require.Equal(t, int32(1), state.LotteryWaitTime)
+	require.Equal(t, nowMillis+1*1000, state.ServerTime)
+
+	// next theft is scheduled after the theft
+	clock.Advance(25 * time.Second)
+	state, err = client.GetGameState()
+	require.NoError(t, err)
+	require.Equal(t, int32(4), state.RemainingTime)
+	require.Equal(t, int32(24), state.TheftWaitTime)
+	require.Equal(t, int32(0), state.LotteryWaitTime)
+}
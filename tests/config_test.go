@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameConfigValidate(t *testing.T) {
+	config := newTestGameConfig()
+	require.NoError(t, config.Validate())
+
+	config.SetQuestionAnswerTime(0)
+	require.NotNil(t, config.Validate())
+
+	config = newTestGameConfig()
+	config.SetLotteryPayouts(4, []int32{0, 50, 100}, true)
+	require.NotNil(t, config.Validate())
+
+	config.SetLotteryPayouts(3, []int32{0, 50, 120}, true)
+	require.NotNil(t, config.Validate())
+
+	// lottery max win is 150 points in test config
+	config.SetLotteryPayouts(3, []int32{0, 50, 120}, false)
+	require.NoError(t, config.Validate())
+
+	config.SetLotteryPayouts(3, []int32{0, 50, 151}, false)
+	require.NotNil(t, config.Validate())
+
+	config.SetLotteryPayouts(0, nil, true)
+	require.NotNil(t, config.Validate())
+
+	config = newTestGameConfig()
+	config.SetStreamQueue(0, "drop")
+	require.NotNil(t, config.Validate())
+	config.SetStreamQueue(16, "block")
+	require.NotNil(t, config.Validate())
+	config.SetStreamQueue(16, "drop")
+	require.NoError(t, config.Validate())
+
+	config = newTestGameConfig()
+	config.SetAutoStart(3, 2, 0)
+	require.NotNil(t, config.Validate())
+	config.SetAutoStart(0, 2, 10)
+	require.NotNil(t, config.Validate())
+	config.SetAutoStart(-1, 0, 0)
+	require.NotNil(t, config.Validate())
+	config.SetAutoStart(2, 0, 10)
+	require.NoError(t, config.Validate())
+
+	questionWinPercentages := map[string]int32{"easy": 150, "medium": 200, "hard": 300}
+	config = server.NewGameConfig(30, 200, 400, 20, 30, 1, 1, 25, 15, 2, 150, questionWinPercentages)
+	require.NotNil(t, config.Validate())
+
+	config = server.NewGameConfig(0, 200, 400, 30, 20, 1, 1, 25, 15, 2, 150, questionWinPercentages)
+	require.NotNil(t, config.Validate())
+	config = server.NewGameConfig(30, 200, 400, 30, 20, 0, 1, 25, 15, 2, 150, questionWinPercentages)
+	require.NotNil(t, config.Validate())
+	config = server.NewGameConfig(30, 200, 400, 30, 20, 1, 1, 25, 15, 2, 150, map[string]int32{"easy": 150})
+	require.NotNil(t, config.Validate())
+}
+
+func TestConfigurableLottery(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetLotteryPayouts(4, []int32{0, 10, 50, 100}, true)
+	addr, clock := launchManualClockServer(t, config)
+	client := server.NewSampleClient()
+	require.NoError(t, client.Connect(addr))
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.NoError(t, client.StartGame())
+
+	// lottery max win is 150 points in test config
+	require.Equal(t, int32(4), joinRes.LotteryCellCount)
+	require.Equal(t, []int32{0, 15, 75, 150}, joinRes.LotteryCellValues)
+
+	_, err = client.PlayLottery(5)
+	require.NotNil(t, err)
+
+	clock.Advance(2 * time.Second)
+	res, err := client.PlayLottery(4)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.ElementsMatch(t, joinRes.LotteryCellValues, res.CellValues)
+	require.Equal(t, res.CellValues[3], res.WinPoints)
+}
@@ -0,0 +1,307 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// receiveSequences returns sequence numbers of all events,
+// which client receives until the game finishes.
+func receiveSequences(t *testing.T, client *server.SampleClient) []int64 {
+	var sequences []int64
+	for {
+		res, err := client.ReceiveEvent()
+		require.NoError(t, err)
+		sequences = append(sequences, res.Sequence)
+		if res.GetFinish() != nil {
+			return sequences
+		}
+	}
+}
+
+func TestEventsAreSequenced(t *testing.T) {
+	addr, clock := launchManualClockServer(t, newTestGameConfig())
+
+	client1 := server.NewSampleClient()
+	client2 := server.NewSampleClient()
+	startTestGame(t, addr, client1, client2)
+	var startSequences []int64
+	for _, client := range []*server.SampleClient{client1, client2} {
+		require.NoError(t, client.OpenStream())
+		start := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+			return res.GetStart() != nil
+		})
+		startSequences = append(startSequences, start.Sequence)
+	}
+	require.Equal(t, startSequences[0], startSequences[1])
+
+	// both players make transactions at the same time
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for _, client := range []*server.SampleClient{client1, client2} {
+		wg.Add(1)
+		go func(client *server.SampleClient) {
+			defer wg.Done()
+			for i := 0; i < 5; i++ {
+				_, err := client.TakeCredit(10)
+				errs <- err
+				_, err = client.TakeDeposit(5)
+				errs <- err
+			}
+		}(client)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	clock.Advance(30 * time.Second)
+	sequences1 := receiveSequences(t, client1)
+	sequences2 := receiveSequences(t, client2)
+
+	// 20 transactions, 20 returns, theft and finish
+	require.Len(t, sequences1, 42)
+	for i, sequence := range sequences1 {
+		require.Equal(t, startSequences[0]+int64(i)+1, sequence)
+	}
+	require.Equal(t, sequences1, sequences2)
+}
+
+func TestStreamReplaysMissedEvents(t *testing.T) {
+	addr := launchTestServer(t, newTestGameConfig())
+	client1 := server.NewSampleClient()
+	client2 := server.NewSampleClient()
+	startTestGame(t, addr, client1, client2)
+
+	require.NoError(t, client1.OpenStream())
+	start := receiveEvent(t, client1, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+
+	res1, err := client1.TakeCredit(10)
+	require.NoError(t, err)
+	require.True(t, res1.Success)
+	res2, err := client2.TakeDeposit(5)
+	require.NoError(t, err)
+	require.True(t, res2.Success)
+
+	// player, who hasn't opened the stream, receives all events of the game
+	require.NoError(t, client2.OpenStream())
+	for sequence := int64(1); sequence <= start.Sequence+2; sequence++ {
+		res, err := client2.ReceiveEvent()
+		require.NoError(t, err)
+		require.Equal(t, sequence, res.Sequence)
+	}
+
+	// reopened stream continues after the last seen event
+	require.NoError(t, client1.OpenStream())
+	res3, err := client1.ReceiveEvent()
+	require.NoError(t, err)
+	require.Equal(t, start.Sequence+1, res3.Sequence)
+	require.NotNil(t, res3.GetTransaction().GetUseCredit())
+	res4, err := client1.ReceiveEvent()
+	require.NoError(t, err)
+	require.NotNil(t, res4.GetTransaction().GetUseDeposit())
+
+	// live events are received after the missed ones
+	_, err = client2.TakeDeposit(5)
+	require.NoError(t, err)
+	for _, client := range []*server.SampleClient{client1, client2} {
+		res, err := client.ReceiveEvent()
+		require.NoError(t, err)
+		require.Equal(t, start.Sequence+3, res.Sequence)
+	}
+
+	client1.LastSeenSequence = 100
+	require.NoError(t, client1.OpenStream())
+	_, err = client1.ReceiveEvent()
+	require.NotNil(t, err)
+}
+
+func TestSlowStreamPolicies(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetStreamQueue(1, "disconnect")
+	require.NoError(t, config.Validate())
+	addr, clock := launchManualClockServer(t, config)
+
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+	require.NoError(t, client.OpenStream())
+	start := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+
+	// credits are returned at the finish all at once,
+	// which is more than the queue can hold
+	clock.Advance(29500 * time.Millisecond)
+	for i := 0; i < 100; i++ {
+		res, err := client.TakeCredit(1)
+		require.NoError(t, err)
+		require.True(t, res.Success)
+	}
+	clock.Advance(500 * time.Millisecond)
+
+	// events are never skipped, the stream is closed instead
+	lastSequence := start.Sequence
+	for {
+		res, err := client.ReceiveEvent()
+		if err != nil {
+			break
+		}
+		require.Equal(t, lastSequence+1, res.Sequence)
+		lastSequence = res.Sequence
+	}
+
+	testDroppedEvents(t)
+}
+
+// testDroppedEvents checks, that the events dropped for the slow
+// stream leave a gap in sequences and are delivered, when the
+// stream is reopened with the last seen sequence.
+func testDroppedEvents(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetStreamQueue(1, "drop")
+	require.NoError(t, config.Validate())
+	addr, _ := launchManualClockServer(t, config)
+
+	clients := make([]*server.SampleClient, 10)
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+	}
+	startTestGame(t, addr, clients...)
+
+	// the flow control window of the slow stream is fixed,
+	// so the server can't send more, until the stream is read
+	slow := clients[0]
+	conn, err := grpc.Dial(
+		addr, grpc.WithInsecure(), grpc.WithInitialWindowSize(1<<16), grpc.WithInitialConnWindowSize(1<<16),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	slow.GameClient = pb.NewGameClient(conn)
+	require.NoError(t, slow.OpenStream())
+	start := receiveEvent(t, slow, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+
+	// events contain all players, so these don't fit into the
+	// window and the send buffer of the server, the rest are dropped
+	for _, client := range clients[1:] {
+		for i := 0; i < 50; i++ {
+			res, err := client.TakeCredit(1)
+			require.NoError(t, err)
+			require.True(t, res.Success)
+		}
+	}
+	lastSequence := start.Sequence + 450
+
+	stream := slow.Stream
+	received := make(chan *pb.StreamResponse, 1000)
+	go func() {
+		defer close(received)
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			received <- res
+		}
+	}()
+
+	// events are sent, until one of them gets through the drained queue
+	var sequences []int64
+	for sequences == nil || sequences[len(sequences)-1] < lastSequence {
+		require.Less(t, lastSequence, start.Sequence+550, "no event has been delivered after the drop")
+		res, err := clients[1].TakeCredit(1)
+		require.NoError(t, err)
+		require.True(t, res.Success)
+		lastSequence++
+		timeout := time.After(100 * time.Millisecond)
+	receiving:
+		for {
+			select {
+			case res := <-received:
+				sequences = append(sequences, res.Sequence)
+			case <-timeout:
+				break receiving
+			}
+		}
+	}
+
+	missingSequence := int64(0)
+	for i, sequence := range append([]int64{start.Sequence}, sequences...) {
+		if sequence != start.Sequence+int64(i) {
+			missingSequence = start.Sequence + int64(i)
+			break
+		}
+	}
+	require.NotZero(t, missingSequence, "no event has been dropped")
+
+	// the old stream is closed after the new one is opened
+	slow.LastSeenSequence = missingSequence - 1
+	require.NoError(t, slow.OpenStream())
+	// draining events of the old stream
+	for range received {
+	}
+	res, err := slow.ReceiveEvent()
+	require.NoError(t, err)
+	require.Equal(t, missingSequence, res.Sequence)
+}
+
+func TestSpectate(t *testing.T) {
+	addr, clock := launchManualClockServer(t, newTestGameConfig())
+
+	client1 := server.NewSampleClient()
+	client2 := server.NewSampleClient()
+	startTestGame(t, addr, client1, client2)
+	res1, err := client1.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, res1.Success)
+
+	// spectator receives the whole history of the game
+	spectator := server.NewSampleClient()
+	require.NoError(t, spectator.Connect(addr))
+	require.NoError(t, spectator.Spectate(string(client1.GameID)))
+	var joins int
+	var res *pb.StreamResponse
+	for sequence := int64(1); sequence <= 4; sequence++ {
+		res, err = spectator.ReceiveEvent()
+		require.NoError(t, err)
+		require.Equal(t, sequence, res.Sequence)
+		if res.GetJoin() != nil {
+			joins++
+		}
+	}
+	require.Equal(t, 2, joins)
+	require.Equal(t, int32(100), res.GetTransaction().GetUseCredit().Value)
+
+	// and live events
+	res2, err := client2.TakeDeposit(50)
+	require.NoError(t, err)
+	require.True(t, res2.Success)
+	res = receiveEvent(t, spectator, func(res *pb.StreamResponse) bool {
+		return res.GetTransaction() != nil
+	})
+	require.Equal(t, string(client2.UserID), res.GetTransaction().GetUseDeposit().UserId)
+
+	// spectator is not a player of the game
+	clock.Advance(30 * time.Second)
+	res = receiveEvent(t, spectator, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	})
+	require.Len(t, res.GetFinish().Players, 3) // with the bank
+	for _, player := range res.GetFinish().Players {
+		require.Contains(t, []string{string(client1.UserID), string(client2.UserID), "bank"}, player.UserId)
+	}
+
+	require.NoError(t, spectator.Spectate("no-such-game"))
+	_, err = spectator.ReceiveEvent()
+	require.NotNil(t, err)
+}
@@ -0,0 +1,379 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/pb"
+	"github.com/stretchr/testify/require"
+)
+
+// findLobby returns the lobby with the join code from the list, or nil.
+func findLobby(t *testing.T, client *server.SampleClient, joinCode string) *pb.ListLobbiesResponse_Lobby {
+	res, err := client.ListLobbies()
+	require.NoError(t, err)
+	for _, lobby := range res.Lobbies {
+		if lobby.JoinCode == joinCode {
+			return lobby
+		}
+	}
+	return nil
+}
+
+func TestLobbies(t *testing.T) {
+	addr := launchTestServer(t, newTestGameConfig())
+	clients := make([]*server.SampleClient, 5)
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+		require.NoError(t, clients[i].Connect(addr))
+	}
+
+	publicRes, err := clients[0].CreateLobby("Section 1", true)
+	require.NoError(t, err)
+	require.Len(t, publicRes.JoinCode, 6)
+	require.Equal(t, "Section 1", publicRes.LobbyName)
+	privateRes, err := clients[1].CreateLobby("Section 2", false)
+	require.NoError(t, err)
+	require.NotEqual(t, publicRes.JoinCode, privateRes.JoinCode)
+	require.NotEqual(t, publicRes.GameId, privateRes.GameId)
+
+	lobby := findLobby(t, clients[0], publicRes.JoinCode)
+	require.NotNil(t, lobby)
+	require.Equal(t, "Section 1", lobby.Name)
+	require.Equal(t, int32(1), lobby.PlayerCount)
+	require.Nil(t, findLobby(t, clients[0], privateRes.JoinCode))
+
+	// join codes are case-insensitive
+	joinRes, err := clients[2].JoinLobby(strings.ToLower(publicRes.JoinCode))
+	require.NoError(t, err)
+	require.Equal(t, publicRes.GameId, joinRes.GameId)
+	require.Len(t, joinRes.Players, 3) // with the bank
+	require.Equal(t, int32(2), findLobby(t, clients[0], publicRes.JoinCode).PlayerCount)
+
+	_, err = clients[3].JoinLobby("NOSUCH")
+	require.NotNil(t, err)
+
+	// quick match doesn't join lobbies
+	quickRes, err := clients[3].JoinGame()
+	require.NoError(t, err)
+	require.Empty(t, quickRes.JoinCode)
+	require.NotEqual(t, publicRes.GameId, quickRes.GameId)
+	require.NotEqual(t, privateRes.GameId, quickRes.GameId)
+
+	// started lobby can't be joined
+	require.NoError(t, clients[0].StartGame())
+	require.Nil(t, findLobby(t, clients[0], publicRes.JoinCode))
+	_, err = clients[4].JoinLobby(publicRes.JoinCode)
+	require.NotNil(t, err)
+	res, err := clients[0].TakeCredit(10)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+
+	// quick-match game is still waiting
+	quickRes2, err := clients[4].JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, quickRes.GameId, quickRes2.GameId)
+
+	// lobby is closed, when the last player leaves
+	require.NoError(t, clients[1].LeaveGame())
+	_, err = clients[4].JoinLobby(privateRes.JoinCode)
+	require.NotNil(t, err)
+}
+
+func TestConfigureLobby(t *testing.T) {
+	addr := launchTestServer(t, newTestGameConfig())
+	host := server.NewSampleClient()
+	require.NoError(t, host.Connect(addr))
+	guest := server.NewSampleClient()
+	require.NoError(t, guest.Connect(addr))
+
+	hostRes, err := host.CreateLobby("High theft", false)
+	require.NoError(t, err)
+	_, err = guest.JoinLobby(hostRes.JoinCode)
+	require.NoError(t, err)
+	require.NoError(t, guest.OpenStream())
+
+	questionWinPercentages := map[string]int32{"easy": 100, "medium": 150, "hard": 200}
+	config := server.NewGameConfig(60, 300, 500, 40, 10, 5, 5, 10, 50, 3, 100, questionWinPercentages)
+	config.SetLotteryPayouts(3, []int32{0, 50, 100}, true)
+
+	// only the host can configure the lobby
+	require.NotNil(t, guest.ConfigureLobby(config))
+	require.NoError(t, host.ConfigureLobby(config))
+
+	// players in the lobby receive the new config
+	res := receiveEvent(t, guest, func(res *pb.StreamResponse) bool {
+		return res.GetConfig() != nil
+	})
+	require.Equal(t, int32(50), res.GetConfig().TheftPercentage)
+	require.Equal(t, []int32{0, 50, 100}, res.GetConfig().LotteryPayouts)
+
+	// players, who join later, receive the new config in join response
+	lateGuest := server.NewSampleClient()
+	require.NoError(t, lateGuest.Connect(addr))
+	joinRes, err := lateGuest.JoinLobby(hostRes.JoinCode)
+	require.NoError(t, err)
+	require.Equal(t, int32(60), joinRes.Duration)
+	require.Equal(t, int32(300), joinRes.PlayerPoints)
+	require.Equal(t, int32(50), joinRes.TheftPercentage)
+	require.Equal(t, []int32{0, 50, 100}, joinRes.LotteryCellValues)
+	require.Equal(t, int32(150), joinRes.QuestionWinPercentages[1].WinPercentage)
+
+	// config is validated in the same way as the server config
+	invalidConfig := server.NewGameConfig(60, 300, 500, 10, 40, 5, 5, 10, 50, 3, 100, questionWinPercentages)
+	require.NotNil(t, host.ConfigureLobby(invalidConfig))
+	negativeWinPercentages := map[string]int32{"easy": 100, "medium": -150, "hard": 200}
+	invalidConfig = server.NewGameConfig(60, 300, 500, 40, 10, 5, 5, 10, 50, 3, 100, negativeWinPercentages)
+	require.NotNil(t, host.ConfigureLobby(invalidConfig))
+
+	// the game is played with the new config
+	require.NoError(t, host.StartGame())
+	_, err = host.PlayLottery(4)
+	require.NotNil(t, err)
+	require.NotNil(t, host.ConfigureLobby(config))
+
+	// quick-match game can't be configured
+	quick := server.NewSampleClient()
+	require.NoError(t, quick.Connect(addr))
+	_, err = quick.JoinGame()
+	require.NoError(t, err)
+	require.NotNil(t, quick.ConfigureLobby(config))
+}
+
+func TestHost(t *testing.T) {
+	addr := launchTestServer(t, newTestGameConfig())
+	clients := make([]*server.SampleClient, 4)
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+		require.NoError(t, clients[i].Connect(addr))
+	}
+	host, guest, kicked, late := clients[0], clients[1], clients[2], clients[3]
+
+	hostRes, err := host.CreateLobby("Section 3", false)
+	require.NoError(t, err)
+	require.Equal(t, hostRes.UserId, hostRes.HostUserId)
+	guestRes, err := guest.JoinLobby(hostRes.JoinCode)
+	require.NoError(t, err)
+	require.Equal(t, hostRes.UserId, guestRes.HostUserId)
+	_, err = kicked.JoinLobby(hostRes.JoinCode)
+	require.NoError(t, err)
+	require.NoError(t, guest.OpenStream())
+	require.NoError(t, kicked.OpenStream())
+	// waiting for the own join event, so that the stream is registered
+	receiveEvent(t, kicked, func(res *pb.StreamResponse) bool {
+		return res.GetJoin().GetPlayer().GetUserId() == string(kicked.UserID)
+	})
+
+	// only the host can start, kick and lock
+	require.NotNil(t, guest.StartGame())
+	require.NotNil(t, guest.Kick(string(kicked.UserID)))
+	require.NotNil(t, guest.LockLobby(true))
+	require.NotNil(t, host.Kick(string(host.UserID)))
+
+	// locked lobby can't be joined
+	require.NoError(t, host.LockLobby(true))
+	res := receiveEvent(t, guest, func(res *pb.StreamResponse) bool {
+		return res.GetLobbyState() != nil
+	})
+	require.True(t, res.GetLobbyState().Locked)
+	require.Equal(t, hostRes.UserId, res.GetLobbyState().HostUserId)
+	_, err = late.JoinLobby(hostRes.JoinCode)
+	require.NotNil(t, err)
+
+	// kicked player receives the leave event, and then the stream is closed
+	require.NoError(t, host.Kick(string(kicked.UserID)))
+	res = receiveEvent(t, kicked, func(res *pb.StreamResponse) bool {
+		return res.GetLeave() != nil
+	})
+	require.Equal(t, string(kicked.UserID), res.GetLeave().UserId)
+	require.True(t, res.GetLeave().Kicked)
+	_, err = kicked.ReceiveEvent()
+	require.NotNil(t, err)
+
+	// next player becomes the host, when the host leaves
+	require.NoError(t, host.LeaveGame())
+	res = receiveEvent(t, guest, func(res *pb.StreamResponse) bool {
+		return res.GetLobbyState() != nil
+	})
+	require.Equal(t, guestRes.UserId, res.GetLobbyState().HostUserId)
+	require.True(t, res.GetLobbyState().Locked)
+
+	require.NoError(t, guest.LockLobby(false))
+	lateRes, err := late.JoinLobby(hostRes.JoinCode)
+	require.NoError(t, err)
+	require.Equal(t, guestRes.UserId, lateRes.HostUserId)
+	require.NoError(t, guest.StartGame())
+}
+
+func TestAutoStart(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetAutoStart(2, 3, 10)
+	require.NoError(t, config.Validate())
+
+	addr, clock := launchManualClockServer(t, config)
+
+	clients := make([]*server.SampleClient, 7)
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+		require.NoError(t, clients[i].Connect(addr))
+	}
+	isCountdown := func(res *pb.StreamResponse) bool {
+		return res.GetCountdown() != nil
+	}
+
+	// countdown begins with min players
+	joinRes, err := clients[0].JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(2), joinRes.MinPlayers)
+	require.Equal(t, int32(3), joinRes.MaxPlayers)
+	require.Equal(t, int32(10), joinRes.StartCountdown)
+	require.NoError(t, clients[0].OpenStream())
+	_, err = clients[1].JoinGame()
+	require.NoError(t, err)
+	res := receiveEvent(t, clients[0], isCountdown)
+	require.Equal(t, int32(10), res.GetCountdown().RemainingTime)
+	require.False(t, res.GetCountdown().Cancelled)
+
+	// and is cancelled, when there are less players
+	require.NoError(t, clients[1].LeaveGame())
+	res = receiveEvent(t, clients[0], isCountdown)
+	require.True(t, res.GetCountdown().Cancelled)
+	clock.Advance(10 * time.Second)
+
+	_, err = clients[2].JoinGame()
+	require.NoError(t, err)
+	receiveEvent(t, clients[0], isCountdown)
+	clock.Advance(5 * time.Second)
+	snapshot, err := clients[2].Resume()
+	require.NoError(t, err)
+	require.Equal(t, int32(5), snapshot.StartCountdown)
+	clock.Advance(5 * time.Second)
+	receiveEvent(t, clients[0], func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+	creditRes, err := clients[2].TakeCredit(10)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+
+	// full game starts right away and next players join a new game
+	var gameIDs []string
+	for _, client := range clients[3:] {
+		joinRes, err := client.JoinGame()
+		require.NoError(t, err)
+		gameIDs = append(gameIDs, joinRes.GameId)
+	}
+	require.Equal(t, gameIDs[0], gameIDs[2])
+	require.NotEqual(t, gameIDs[2], gameIDs[3])
+	creditRes, err = clients[5].TakeCredit(10)
+	require.NoError(t, err)
+	require.True(t, creditRes.Success)
+	_, err = clients[6].TakeCredit(10)
+	require.NotNil(t, err)
+}
+
+func TestAutoStartLateJoiner(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetAutoStart(2, 0, 10)
+	require.NoError(t, config.Validate())
+
+	addr, clock := launchManualClockServer(t, config)
+
+	clients := make([]*server.SampleClient, 3)
+	for i := range clients {
+		clients[i] = server.NewSampleClient()
+		require.NoError(t, clients[i].Connect(addr))
+	}
+
+	joinRes, err := clients[0].JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(0), joinRes.RemainingCountdown)
+	joinRes, err = clients[1].JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(10), joinRes.RemainingCountdown)
+
+	// the player joining during the countdown gets its remaining time
+	clock.Advance(4 * time.Second)
+	joinRes, err = clients[2].JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(6), joinRes.RemainingCountdown)
+
+	require.NoError(t, clients[2].OpenStream())
+	clock.Advance(6 * time.Second)
+	receiveEvent(t, clients[2], func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+}
+
+func TestRematch(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetRematchTime(30)
+	require.NoError(t, config.Validate())
+
+	addr, clock := launchManualClockServer(t, config)
+
+	host := server.NewSampleClient()
+	require.NoError(t, host.Connect(addr))
+	guest := server.NewSampleClient()
+	require.NoError(t, guest.Connect(addr))
+
+	hostRes, err := host.CreateLobby("Section 4", true)
+	require.NoError(t, err)
+	questionWinPercentages := map[string]int32{"easy": 100, "medium": 150, "hard": 200}
+	lobbyConfig := server.NewGameConfig(60, 300, 500, 40, 10, 5, 5, 10, 50, 3, 100, questionWinPercentages)
+	require.NoError(t, host.ConfigureLobby(lobbyConfig))
+	_, err = guest.JoinLobby(hostRes.JoinCode)
+	require.NoError(t, err)
+	require.NoError(t, host.StartGame())
+	require.NoError(t, host.OpenStream())
+	receiveEvent(t, host, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+
+	// finish contains the rematch lobby
+	clock.Advance(60 * time.Second)
+	res := receiveEvent(t, host, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	})
+	rematchGameID := res.GetFinish().RematchGameId
+	require.NotEmpty(t, rematchGameID)
+	require.Equal(t, int32(30), res.GetFinish().RematchTime)
+
+	// only players of the game can join the rematch
+	outsider := server.NewSampleClient()
+	require.NoError(t, outsider.Connect(addr))
+	outsider.GameID = host.GameID
+	_, err = outsider.Rematch()
+	require.NotNil(t, err)
+
+	// rematch has the same config and the player, who joins first, is its host
+	oldGuestUserID := guest.UserID
+	guestRes, err := guest.Rematch()
+	require.NoError(t, err)
+	require.Equal(t, rematchGameID, guestRes.GameId)
+	require.NotEqual(t, string(oldGuestUserID), guestRes.UserId)
+	require.Equal(t, guestRes.UserId, guestRes.HostUserId)
+	require.Equal(t, int32(60), guestRes.Duration)
+	require.Equal(t, int32(50), guestRes.TheftPercentage)
+	require.Equal(t, "Section 4", guestRes.LobbyName)
+	require.Nil(t, findLobby(t, guest, guestRes.JoinCode)) // rematch is private
+
+	// outsiders can't join the rematch with its join code
+	_, err = outsider.JoinLobby(guestRes.JoinCode)
+	require.NotNil(t, err)
+
+	guest.UserID = oldGuestUserID
+	guest.GameID = host.GameID
+	_, err = guest.Rematch()
+	require.NotNil(t, err)
+
+	// rematch can't be joined after the rematch time,
+	// but the lobby stays, since it has players
+	clock.Advance(30 * time.Second)
+	_, err = host.Rematch()
+	require.NotNil(t, err)
+	joinRes, err := host.JoinLobby(guestRes.JoinCode)
+	require.NoError(t, err)
+	require.Equal(t, guestRes.UserId, joinRes.HostUserId)
+}
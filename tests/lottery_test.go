@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyLottery(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	cellValues := []int32{30, 0, 150, 45, 0, 90, 45, 30, 90}
+	commitment := server.LotteryCommitment(salt, cellValues)
+	require.Len(t, commitment, 64)
+	require.Equal(t, commitment, server.LotteryCommitment(salt, cellValues))
+
+	require.NoError(t, server.VerifyLottery(commitment, salt, cellValues, 3, 150))
+
+	// the board has been changed after the commitment
+	swapped := []int32{150, 0, 30, 45, 0, 90, 45, 30, 90}
+	require.NotNil(t, server.VerifyLottery(commitment, salt, swapped, 1, 150))
+
+	require.NotNil(t, server.VerifyLottery(commitment, []byte("another salt"), cellValues, 3, 150))
+	require.NotNil(t, server.VerifyLottery(commitment, salt, cellValues, 3, 30))
+	require.NotNil(t, server.VerifyLottery(commitment, salt, cellValues, 10, 0))
+}
+
+func TestCommittedLottery(t *testing.T) {
+	addr, clock := launchManualClockServer(t, newTestGameConfig())
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+
+	commitmentRes1, err := client.GameClient.LotteryCommitment(
+		context.Background(), client.GetLotteryCommitmentRequest(),
+	)
+	require.NoError(t, err)
+	commitmentRes2, err := client.GameClient.LotteryCommitment(
+		context.Background(), client.GetLotteryCommitmentRequest(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, commitmentRes1.Commitment, commitmentRes2.Commitment)
+
+	// calling too early doesn't reveal the committed board
+	res1, err := client.PlayLottery(1)
+	require.NoError(t, err)
+	require.False(t, res1.Success)
+	require.Empty(t, res1.Commitment)
+
+	clock.Advance(2 * time.Second)
+	res2, err := client.PlayLottery(5)
+	require.NoError(t, err)
+	require.True(t, res2.Success)
+	require.Equal(t, commitmentRes1.Commitment, res2.Commitment)
+	require.NoError(t, server.VerifyLottery(res2.Commitment, res2.Salt, res2.CellValues, 5, res2.WinPoints))
+
+	// the commitment is used only once
+	clock.Advance(2 * time.Second)
+	res3, err := client.PlayLottery(5)
+	require.NoError(t, err)
+	require.True(t, res3.Success)
+	require.Empty(t, res3.Commitment)
+
+	clock.Advance(2 * time.Second)
+	res4, err := client.PlayCommittedLottery(2)
+	require.NoError(t, err)
+	require.True(t, res4.Success)
+	require.NotEqual(t, res2.Commitment, res4.Commitment)
+}
+
+// receiveLotteryTransaction reads events from the client stream
+// until the lottery transaction is received.
+func receiveLotteryTransaction(t *testing.T, client *server.SampleClient) *pb.StreamResponse_Transaction {
+	res := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetTransaction().GetLottery() != nil
+	})
+	return res.GetTransaction()
+}
+
+// requireMoneyIsConserved checks that points of players, bank
+// and jackpot add up to the initial amount of money in the game.
+func requireMoneyIsConserved(t *testing.T, transaction *pb.StreamResponse_Transaction) {
+	total := transaction.Jackpot
+	for _, player := range transaction.Players {
+		total += player.Points
+	}
+	// one of the players is the bank
+	playerCount := int32(len(transaction.Players) - 1)
+	// test config has 200 player points and 400 bank points per player
+	require.Equal(t, playerCount*(200+400), total)
+}
+
+func TestJackpotLottery(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetLotteryPayouts(1, []int32{server.LotteryJackpotPayout}, true)
+	require.NotNil(t, config.Validate(), "jackpot cell without ticket price")
+
+	config.SetLotteryTicket(20, 50)
+	config.SetLotteryPayouts(2, []int32{server.LotteryJackpotPayout, server.LotteryJackpotPayout}, true)
+	require.NotNil(t, config.Validate(), "two jackpot cells")
+
+	// every lottery loses, so the jackpot grows
+	config.SetLotteryPayouts(1, []int32{0}, true)
+	addr, clock := launchManualClockServer(t, config)
+	client := server.NewSampleClient()
+	require.NoError(t, client.Connect(addr))
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.Equal(t, int32(20), joinRes.LotteryTicketPrice)
+	require.Equal(t, int32(50), joinRes.LotteryJackpotShare)
+	require.NoError(t, client.OpenStream())
+	require.NoError(t, client.StartGame())
+
+	for i := 1; i <= 2; i++ {
+		clock.Advance(2 * time.Second)
+		res, err := client.PlayLottery(1)
+		require.NoError(t, err)
+		require.True(t, res.Success)
+		require.Equal(t, int32(0), res.WinPoints)
+
+		transaction := receiveLotteryTransaction(t, client)
+		require.Equal(t, int32(i*10), transaction.Jackpot)
+		require.Equal(t, int32(20), transaction.GetLottery().TicketPrice)
+		requireMoneyIsConserved(t, transaction)
+	}
+
+	// the jackpot goes back to the bank at the finish
+	clock.Advance(30 * time.Second)
+	finish := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	}).GetFinish()
+	total := int32(0)
+	for _, player := range finish.Players {
+		total += player.Points
+	}
+	require.Equal(t, int32(200+400), total)
+
+	// every lottery wins the jackpot
+	config.SetLotteryPayouts(1, []int32{server.LotteryJackpotPayout}, true)
+	require.NoError(t, config.Validate())
+	addr, clock = launchManualClockServer(t, config)
+	client = server.NewSampleClient()
+	startTestGame(t, addr, client)
+	require.NoError(t, client.OpenStream())
+
+	clock.Advance(2 * time.Second)
+	res, err := client.PlayCommittedLottery(1)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+	require.Equal(t, []int32{server.LotteryJackpotPayout}, res.CellValues)
+	require.Equal(t, int32(10), res.WinPoints)
+
+	transaction := receiveLotteryTransaction(t, client)
+	require.True(t, transaction.GetLottery().JackpotWon)
+	require.Equal(t, int32(0), transaction.Jackpot)
+	requireMoneyIsConserved(t, transaction)
+}
@@ -0,0 +1,282 @@
+package tests
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/pb"
+	"github.com/stretchr/testify/require"
+)
+
+const testQuestionBankPath = "../questions/sample.json"
+
+func TestLocalQuestionProvider(t *testing.T) {
+	provider, err := server.NewLocalQuestionProvider(testQuestionBankPath)
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		question, err := provider.GetQuestion(server.QuestionFilter{Type: "multiple"})
+		require.NoError(t, err)
+		require.NotEmpty(t, question.Text)
+		require.NotEmpty(t, question.CorrectAnswer)
+		require.Len(t, question.IncorrectAnswers, 3)
+		require.NotContains(t, question.IncorrectAnswers, question.CorrectAnswer)
+	}
+
+	_, err = server.NewLocalQuestionProvider("no/such/file.json")
+	require.NotNil(t, err)
+}
+
+func TestLoadQuestionBank(t *testing.T) {
+	for _, path := range []string{
+		testQuestionBankPath,
+		"testdata/questions.csv",
+		"testdata/questions.yaml",
+		"testdata/mixed_questions.csv",
+	} {
+		questions, err := server.LoadQuestionBank(path)
+		require.NoError(t, err, path)
+		require.NotEmpty(t, questions, path)
+		for _, question := range questions {
+			require.NotEmpty(t, question.Text)
+			require.NotEmpty(t, question.Category)
+			switch question.Type {
+			case "multiple":
+				require.Len(t, question.IncorrectAnswers, 3)
+			case "boolean":
+				require.Len(t, question.IncorrectAnswers, 1)
+			case "numeric":
+				require.Empty(t, question.IncorrectAnswers)
+				require.LessOrEqual(t, question.AnswerMin, question.NumericAnswer)
+				require.LessOrEqual(t, question.NumericAnswer, question.AnswerMax)
+			default:
+				require.Fail(t, "unknown question type", question.Type)
+			}
+		}
+	}
+
+	questions, err := server.LoadQuestionBank("testdata/questions.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "Trademark", questions[1].CorrectAnswer)
+
+	// all invalid questions are reported with their line numbers
+	_, err = server.LoadQuestionBank("testdata/invalid_questions.json")
+	require.NotNil(t, err)
+	require.NotContains(t, err.Error(), "invalid_questions.json:2:")
+	require.Contains(t, err.Error(), "invalid_questions.json:9: question text is empty")
+	require.Contains(t, err.Error(), "invalid_questions.json:16: answer \"CFAA\" is repeated")
+	require.Contains(t, err.Error(), "invalid_questions.json:23: correct answer has to be an index from 1 to 4")
+
+	_, err = server.LoadQuestionBank("testdata/invalid_questions.csv")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "3: expected 8 fields, got 7")
+
+	// quoted fields can contain line breaks
+	questions, err = server.LoadQuestionBank("testdata/mixed_questions.csv")
+	require.NoError(t, err)
+	require.Contains(t, questions[len(questions)-1].Text, "\n")
+
+	// empty answers aren't skipped, so they are reported
+	_, err = server.LoadQuestionBank("testdata/invalid_answers.csv")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "invalid_answers.csv:2: answer 2 is empty")
+	require.Contains(t, err.Error(), "invalid_answers.csv:4: category is empty")
+	require.Contains(t, err.Error(), "invalid_answers.csv:5: answer 2 is empty")
+
+	provider, err := server.NewLocalQuestionProvider(
+		testQuestionBankPath, "testdata/questions.csv", "testdata/questions.yaml",
+	)
+	require.NoError(t, err)
+	_, err = provider.GetQuestion(server.QuestionFilter{})
+	require.NoError(t, err)
+}
+
+// numberedQuestionProvider returns questions with
+// increasing numbers and counts the requests.
+type numberedQuestionProvider struct {
+	mutex sync.Mutex
+	count int
+}
+
+func (p *numberedQuestionProvider) GetQuestion(filter server.QuestionFilter) (*server.Question, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.count++
+	return &server.Question{
+		Type:             "multiple",
+		Text:             fmt.Sprintf("Question %d", p.count),
+		CorrectAnswer:    "A",
+		IncorrectAnswers: []string{"B", "C", "D"},
+		Category:         "Test",
+		Difficulty:       "easy",
+	}, nil
+}
+
+func (p *numberedQuestionProvider) Categories() []string {
+	return []string{"Test"}
+}
+
+func (p *numberedQuestionProvider) requestCount() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.count
+}
+
+func TestDefaultQuestionsArePrefetched(t *testing.T) {
+	provider := &numberedQuestionProvider{}
+	s := server.NewServer(newTestGameConfig(), provider)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+
+	// the buffer of the default filter is filled up to the cache size
+	require.Eventually(t, func() bool {
+		return provider.requestCount() == 10
+	}, time.Second, 10*time.Millisecond)
+
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+	res, err := client.DoGenerateQuestion(10)
+	require.NoError(t, err)
+	require.Equal(t, "Question 1", res.Question)
+}
+
+func TestQuestionsAreNotRepeatedInGame(t *testing.T) {
+	addr := launchTestServer(t, newTestGameConfig())
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+
+	// sample question bank has many more questions than generated here
+	questions := make(map[string]bool)
+	for i := 0; i < 4; i++ {
+		res, err := client.DoGenerateQuestion(1)
+		require.NoError(t, err)
+		require.False(t, questions[res.Question], "question %q is repeated", res.Question)
+		questions[res.Question] = true
+	}
+}
+
+// playerPoints returns the points of the client from the snapshot of the game.
+func playerPoints(t *testing.T, client *server.SampleClient) int32 {
+	snapshot, err := client.Resume()
+	require.NoError(t, err)
+	for _, player := range snapshot.Players {
+		if player.UserId == string(client.UserID) {
+			return player.Points
+		}
+	}
+	require.Fail(t, "player is not in the game")
+	return 0
+}
+
+func TestQuestionAnswerDeadline(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetQuestionAnswerTime(1)
+	addr, clock := launchManualClockServer(t, config)
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+
+	res1, err := client.DoGenerateQuestion(10)
+	require.NoError(t, err)
+	res2, err := client.DoAnswerQuestion(res1.QuestionId, 1)
+	require.NoError(t, err)
+	require.False(t, res2.Expired)
+
+	// the question can be answered only once
+	_, err = client.DoAnswerQuestion(res1.QuestionId, 1)
+	require.NotNil(t, err)
+
+	// answering after the deadline forfeits the bid
+	points := playerPoints(t, client)
+	res3, err := client.DoGenerateQuestion(10)
+	require.NoError(t, err)
+	clock.Advance(2 * time.Second)
+	res4, err := client.DoAnswerQuestion(res3.QuestionId, 1)
+	require.NoError(t, err)
+	require.True(t, res4.Expired)
+	require.False(t, res4.AnswerIsCorrect)
+	require.Equal(t, int32(0), res4.WinPoints)
+	require.Equal(t, points-10, playerPoints(t, client))
+
+	// expired question is removed after the answer
+	_, err = client.DoAnswerQuestion(res3.QuestionId, 1)
+	require.NotNil(t, err)
+}
+
+func TestGenerateQuestionWithDifficultyAndCategory(t *testing.T) {
+	addr := launchTestServer(t, newTestGameConfig())
+	client := server.NewSampleClient()
+	require.NoError(t, client.Connect(addr))
+	joinRes, err := client.JoinGame()
+	require.NoError(t, err)
+	require.NoError(t, client.StartGame())
+
+	require.Equal(
+		t,
+		[]string{"Ethical Theories", "Intellectual Property", "Privacy", "Professional Ethics"},
+		joinRes.QuestionCategories,
+	)
+	require.Len(t, joinRes.QuestionWinPercentages, 3)
+	require.Equal(t, pb.QuestionDifficulty_HARD, joinRes.QuestionWinPercentages[2].Difficulty)
+	require.Equal(t, int32(300), joinRes.QuestionWinPercentages[2].WinPercentage)
+
+	// sample question bank has only 2 hard questions, both about privacy
+	hardPrivacyQuestions := []string{
+		"Which principle states that data should only be collected for a specified purpose?",
+		"Which US law sets rules for collecting personal data from children under 13 online?",
+	}
+	res1, err := client.DoGenerateFilteredQuestion(10, pb.QuestionType_MULTIPLE, pb.QuestionDifficulty_HARD, "Privacy")
+	require.NoError(t, err)
+	require.Contains(t, hardPrivacyQuestions, res1.Question)
+
+	res2, err := client.DoGenerateFilteredQuestion(10, pb.QuestionType_MULTIPLE, pb.QuestionDifficulty_HARD, "")
+	require.NoError(t, err)
+	require.Contains(t, hardPrivacyQuestions, res2.Question)
+
+	_, err = client.DoGenerateFilteredQuestion(10, pb.QuestionType_MULTIPLE, pb.QuestionDifficulty_EASY, "Sports")
+	require.NotNil(t, err)
+
+	// there are no hard questions about ethical theories
+	_, err = client.DoGenerateFilteredQuestion(10, pb.QuestionType_MULTIPLE, pb.QuestionDifficulty_HARD, "Ethical Theories")
+	require.NotNil(t, err)
+
+	_, err = client.DoGenerateFilteredQuestion(10, pb.QuestionType_MULTIPLE, pb.QuestionDifficulty(42), "")
+	require.NotNil(t, err)
+}
+
+func TestBooleanAndNumericQuestions(t *testing.T) {
+	addr := launchTestServer(t, newTestGameConfig())
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+
+	res1, err := client.DoGenerateFilteredQuestion(10, pb.QuestionType_BOOLEAN, pb.QuestionDifficulty_EASY, "")
+	require.NoError(t, err)
+	require.Equal(t, pb.QuestionType_BOOLEAN, res1.Type)
+	require.Len(t, res1.Answers, 2)
+	require.Equal(t, int32(1), res1.AnswerMin)
+	require.Equal(t, int32(2), res1.AnswerMax)
+	_, err = client.DoAnswerQuestion(res1.QuestionId, 3)
+	require.NotNil(t, err)
+
+	// the only medium numeric question in the sample bank is about GDPR
+	res2, err := client.DoGenerateFilteredQuestion(10, pb.QuestionType_NUMERIC, pb.QuestionDifficulty_MEDIUM, "")
+	require.NoError(t, err)
+	require.Equal(t, pb.QuestionType_NUMERIC, res2.Type)
+	require.Empty(t, res2.Answers)
+	require.Equal(t, int32(1990), res2.AnswerMin)
+	require.Equal(t, int32(2030), res2.AnswerMax)
+
+	_, err = client.DoAnswerQuestion(res2.QuestionId, 1989)
+	require.NotNil(t, err)
+
+	// estimate is 1 year off with tolerance of 4 years,
+	// so 3/4 of the medium win percentage (200%) is paid
+	res3, err := client.DoAnswerQuestion(res2.QuestionId, 2019)
+	require.NoError(t, err)
+	require.True(t, res3.AnswerIsCorrect)
+	require.Equal(t, int32(2018), res3.CorrectAnswer)
+	require.Equal(t, int32(15), res3.WinPoints)
+}
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/stretchr/testify/require"
+)
+
+// singleQuestionProvider always returns the same question,
+// so that only the game random source affects the answers.
+type singleQuestionProvider struct{}
+
+func (p singleQuestionProvider) GetQuestion(filter server.QuestionFilter) (*server.Question, error) {
+	return &server.Question{
+		Type:             filter.Type,
+		Text:             "Who is known for the categorical imperative?",
+		CorrectAnswer:    "Immanuel Kant",
+		IncorrectAnswers: []string{"John Stuart Mill", "Aristotle", "Thomas Hobbes"},
+		Category:         "Ethical Theories",
+		Difficulty:       filter.Difficulty,
+	}, nil
+}
+
+func (p singleQuestionProvider) Categories() []string {
+	return []string{"Ethical Theories"}
+}
+
+func TestRandomSource(t *testing.T) {
+	random1 := server.NewRandomSource(42)
+	random2 := server.NewRandomSource(42)
+	src := []int32{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for i := 0; i < 10; i++ {
+		shuffled := server.RandShuffle(random1, src)
+		require.ElementsMatch(t, src, shuffled)
+		require.Equal(t, shuffled, server.RandShuffle(random2, src))
+	}
+}
+
+func TestGameIsReproducibleFromSeed(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetRandomSeed(42)
+
+	var answers [][]string
+	var cellValues [][]int32
+	for i := 0; i < 2; i++ {
+		clock := server.NewManualClock(time.Now())
+		s := server.NewServerWithClock(config, singleQuestionProvider{}, clock)
+		addr, err := s.Listen("localhost:0")
+		require.NoError(t, err)
+		go s.Launch()
+
+		client := server.NewSampleClient()
+		startTestGame(t, addr, client)
+
+		res1, err := client.DoGenerateQuestion(1)
+		require.NoError(t, err)
+		answers = append(answers, res1.Answers)
+
+		clock.Advance(2 * time.Second)
+		res2, err := client.PlayLottery(1)
+		require.NoError(t, err)
+		require.True(t, res2.Success)
+		cellValues = append(cellValues, res2.CellValues)
+	}
+
+	require.Equal(t, answers[0], answers[1])
+	require.Equal(t, cellValues[0], cellValues[1])
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResume(t *testing.T) {
+	addr, clock := launchManualClockServer(t, newTestGameConfig())
+
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+	require.NotEmpty(t, client.ReconnectToken)
+
+	res1, err := client.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, res1.Success)
+	res2, err := client.TakeDeposit(50)
+	require.NoError(t, err)
+	require.True(t, res2.Success)
+	res3, err := client.DoGenerateQuestion(10)
+	require.NoError(t, err)
+
+	// client, which has lost its state, only knows the game and the token
+	resumed := server.NewSampleClient()
+	require.NoError(t, resumed.Connect(addr))
+	resumed.GameID = client.GameID
+	resumed.ReconnectToken = client.ReconnectToken
+	snapshot, err := resumed.Resume()
+	require.NoError(t, err)
+
+	require.Equal(t, string(client.UserID), snapshot.UserId)
+	require.Equal(t, string(client.Username), snapshot.Username)
+	require.True(t, snapshot.Started)
+	require.Equal(t, int32(30), snapshot.RemainingTime)
+	require.Equal(t, int32(2), snapshot.LotteryWaitTime)
+	require.Len(t, snapshot.Players, 2)
+	for _, player := range snapshot.Players {
+		if player.UserId == snapshot.UserId {
+			require.Equal(t, int32(240), player.Points)
+		}
+	}
+
+	require.Len(t, snapshot.Credits, 1)
+	require.Equal(t, int32(100), snapshot.Credits[0].Value)
+	require.Equal(t, int32(130), snapshot.Credits[0].ValueWithInterest)
+	require.Equal(t, int32(1), snapshot.Credits[0].ReturnTime)
+	require.Len(t, snapshot.Deposits, 1)
+	require.Equal(t, int32(60), snapshot.Deposits[0].ValueWithInterest)
+
+	require.Len(t, snapshot.Questions, 1)
+	question := snapshot.Questions[0]
+	require.Equal(t, res3.QuestionId, question.QuestionId)
+	require.Equal(t, res3.Question, question.Question)
+	require.Equal(t, res3.Answers, question.Answers)
+	require.Equal(t, int32(10), question.BidPoints)
+	require.Equal(t, int32(30), question.AnswerTime)
+
+	// resumed client continues the game
+	_, err = resumed.DoAnswerQuestion(question.QuestionId, 1)
+	require.NoError(t, err)
+	res4, err := resumed.ReceiveEvent()
+	require.NoError(t, err)
+	require.Equal(t, snapshot.UserId, res4.GetTransaction().GetQuestion().UserId)
+
+	clock.Advance(1 * time.Second)
+	res5 := receiveEvent(t, resumed, func(res *pb.StreamResponse) bool {
+		return res.GetTransaction().GetReturnCredit() != nil
+	})
+	require.Equal(t, int32(130), res5.GetTransaction().GetReturnCredit().Value)
+
+	resumed.ReconnectToken = "invalid"
+	_, err = resumed.Resume()
+	require.NotNil(t, err)
+}
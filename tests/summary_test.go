@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cs489-team11/server"
+	"github.com/cs489-team11/server/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameSummary(t *testing.T) {
+	addr, clock := launchManualClockServer(t, newTestGameConfig())
+
+	client1 := server.NewSampleClient()
+	client2 := server.NewSampleClient()
+	startTestGame(t, addr, client1, client2)
+	require.NoError(t, client1.OpenStream())
+	receiveEvent(t, client1, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+
+	// summary is available only after the finish
+	_, err := client1.GetGameSummary()
+	require.NotNil(t, err)
+
+	res1, err := client1.TakeCredit(100)
+	require.NoError(t, err)
+	require.True(t, res1.Success)
+	res2, err := client2.TakeDeposit(50)
+	require.NoError(t, err)
+	require.True(t, res2.Success)
+	// the question expires without the answer
+	_, err = client2.DoGenerateQuestion(10)
+	require.NoError(t, err)
+
+	clock.Advance(30 * time.Second)
+	finish := receiveEvent(t, client1, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	}).GetFinish()
+	require.Equal(t, string(client2.UserID), finish.WinnerUserId)
+
+	summary := finish.Summary
+	require.Equal(t, []string{string(client2.UserID)}, summary.WinnerUserIds)
+	require.Len(t, summary.Players, 2)
+
+	first, second := summary.Players[0], summary.Players[1]
+
+	// 200 + 100 - 130 - 26 (theft)
+	require.Equal(t, string(client1.UserID), second.UserId)
+	require.Equal(t, int32(2), second.Rank)
+	require.Equal(t, int32(144), second.Points)
+	require.Equal(t, int32(100), second.CreditsTaken)
+	require.Equal(t, int32(30), second.CreditInterestPaid)
+	require.Equal(t, int32(26), second.TheftLosses)
+
+	// 200 - 50 - 10 + 60 - 30 (theft)
+	require.Equal(t, string(client2.UserID), first.UserId)
+	require.Equal(t, int32(1), first.Rank)
+	require.Equal(t, int32(170), first.Points)
+	require.Equal(t, int32(50), first.DepositsMade)
+	require.Equal(t, int32(10), first.DepositInterestEarned)
+	require.Equal(t, int32(10), first.QuestionBidsLost)
+	require.Equal(t, int32(30), first.TheftLosses)
+
+	res3, err := client2.GetGameSummary()
+	require.NoError(t, err)
+	require.Equal(t, summary.Players[0].Points, res3.Players[0].Points)
+	require.Equal(t, summary.WinnerUserIds, res3.WinnerUserIds)
+
+	// summary is kept for an hour
+	clock.Advance(time.Hour)
+	_, err = client2.GetGameSummary()
+	require.NotNil(t, err)
+
+	// players with the same points share the rank
+	client3 := server.NewSampleClient()
+	client4 := server.NewSampleClient()
+	startTestGame(t, addr, client3, client4)
+	require.NoError(t, client3.OpenStream())
+	receiveEvent(t, client3, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+	clock.Advance(30 * time.Second)
+	finish = receiveEvent(t, client3, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	}).GetFinish()
+	require.Empty(t, finish.WinnerUserId)
+	require.Len(t, finish.Summary.WinnerUserIds, 2)
+	for _, player := range finish.Summary.Players {
+		require.Equal(t, int32(1), player.Rank)
+	}
+}
+
+func TestStatementAddsUpToPoints(t *testing.T) {
+	config := newTestGameConfig()
+	config.SetLotteryTicket(20, 50)
+	require.NoError(t, config.Validate())
+	addr, clock := launchManualClockServer(t, config)
+
+	client := server.NewSampleClient()
+	startTestGame(t, addr, client)
+	require.NoError(t, client.OpenStream())
+	receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetStart() != nil
+	})
+
+	_, err := client.TakeCredit(100)
+	require.NoError(t, err)
+	_, err = client.TakeDeposit(50)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		question, err := client.DoGenerateQuestion(20)
+		require.NoError(t, err)
+		_, err = client.DoAnswerQuestion(question.QuestionId, 1)
+		require.NoError(t, err)
+	}
+	// the last question isn't answered
+	_, err = client.DoGenerateQuestion(10)
+	require.NoError(t, err)
+	clock.Advance(2 * time.Second)
+	res, err := client.PlayLottery(1)
+	require.NoError(t, err)
+	require.True(t, res.Success)
+
+	clock.Advance(28 * time.Second)
+	finish := receiveEvent(t, client, func(res *pb.StreamResponse) bool {
+		return res.GetFinish() != nil
+	}).GetFinish()
+	player := finish.Summary.Players[0]
+	require.Equal(t, int32(20), player.LotteryTicketsPaid)
+	require.GreaterOrEqual(t, player.QuestionBidsLost, int32(10))
+
+	// credits and deposits are settled, so only their interest is left
+	points := int32(200) - player.CreditInterestPaid + player.DepositInterestEarned - player.TheftLosses -
+		player.LotteryTicketsPaid + player.LotteryWinnings + player.QuestionBidsWon - player.QuestionBidsLost
+	require.Equal(t, player.Points, points)
+}
@@ -15,6 +15,65 @@ const testServAddr = "localhost:9090" //"178.128.85.78:9090" //"localhost:9090"/
 
 //const testServAddr = "localhost:0"
 
+// newTestGameConfig returns the same config as the one
+// in run instructions for testing.
+func newTestGameConfig() server.GameConfig {
+	questionWinPercentages := map[string]int32{"easy": 150, "medium": 200, "hard": 300}
+	return server.NewGameConfig(30, 200, 400, 30, 20, 1, 1, 25, 15, 2, 150, questionWinPercentages)
+}
+
+// launchTestServer launches a separate server with the provided config
+// and the local question bank. It returns address of the server.
+func launchTestServer(t *testing.T, config server.GameConfig) string {
+	provider, err := server.NewLocalQuestionProvider(testQuestionBankPath)
+	require.NoError(t, err)
+
+	s := server.NewServer(config, provider)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+	return addr
+}
+
+// launchManualClockServer launches a separate server in the same way
+// as launchTestServer. Its games use the returned manual clock.
+func launchManualClockServer(t *testing.T, config server.GameConfig) (string, *server.ManualClock) {
+	provider, err := server.NewLocalQuestionProvider(testQuestionBankPath)
+	require.NoError(t, err)
+
+	clock := server.NewManualClock(time.Now())
+	s := server.NewServerWithClock(config, provider, clock)
+	addr, err := s.Listen("localhost:0")
+	require.NoError(t, err)
+	go s.Launch()
+	return addr, clock
+}
+
+// startTestGame connects the clients to the server at addr,
+// joins them into the same game and starts it.
+func startTestGame(t *testing.T, addr string, clients ...*server.SampleClient) {
+	for _, client := range clients {
+		require.NoError(t, client.Connect(addr))
+		_, err := client.JoinGame()
+		require.NoError(t, err)
+	}
+	require.NoError(t, clients[0].StartGame())
+}
+
+// receiveEvent reads events from the client stream
+// until the event matching the predicate is received.
+func receiveEvent(
+	t *testing.T, client *server.SampleClient, match func(*pb.StreamResponse) bool,
+) *pb.StreamResponse {
+	for {
+		res, err := client.ReceiveEvent()
+		require.NoError(t, err)
+		if match(res) {
+			return res
+		}
+	}
+}
+
 func TestJoinAndLeave(t *testing.T) {
 	var err error
 
@@ -88,15 +147,19 @@ func TestStart(t *testing.T) {
 	joinRes2, err := client2.JoinGame()
 	require.NoError(t, err)
 	require.Equal(t, joinRes1.GameId, joinRes2.GameId)
+	require.Equal(t, joinRes1.UserId, joinRes2.HostUserId)
 
+	// only the host can start the game
 	err = client2.StartGame()
+	require.NotNil(t, err)
+	err = client1.StartGame()
 	require.NoError(t, err)
 
 	joinRes3, err := client3.JoinGame()
 	require.NoError(t, err)
 	// check that after starting, next user joins into different game.
 	// test passes, however, it may happen that client3.JoinGame is handled
-	// before client2.StartGame. In that case, the test may fail.
+	// before client1.StartGame. In that case, the test may fail.
 	require.NotEqual(t, joinRes1.GameId, joinRes3.GameId)
 
 	// check that one player can start a game
@@ -28,3 +28,12 @@ func insertToSlice(a []string, index int, value string) []string {
 	a[index] = value
 	return a
 }
+
+func containsString(a []string, value string) bool {
+	for _, s := range a {
+		if s == value {
+			return true
+		}
+	}
+	return false
+}